@@ -0,0 +1,236 @@
+// Package actor provides typed actors processing their messages one at a time with a functional behaviour.
+package actor
+
+import (
+	"errors"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"glours/go2funk/api/concurrent"
+	"glours/go2funk/api/control"
+)
+
+var (
+	// ErrActorStopped is the cause of the Future returned by Ask when the actor is stopped.
+	ErrActorStopped = errors.New("actor: actor is stopped")
+	// ErrAskTimeout is the cause of the Future returned by Ask when the actor doesn't reply in time.
+	ErrAskTimeout = errors.New("actor: Ask timed out")
+)
+
+// Behavior computes the new state of an actor from its current state and the message it receives.
+// a failed Try is handled by the Strategy of the Supervisor of the actor, so is a panic, with a concurrent.PanicError cause.
+// states should be immutable values, such as the persistent collections, so they can be shared with StateOf.
+type Behavior[S, M any] func(state S, message M) control.Try[S]
+
+// Actor is a handle on an actor receiving messages of type M in its mailbox.
+// the zero value of an Actor is a stopped actor.
+type Actor[M any] struct {
+	cell cell[M]
+}
+
+// cell is the internal view of an actor independent of the type of its state.
+type cell[M any] interface {
+	stopper
+	tell(message M) bool
+	dispatcher() Dispatcher
+	done() <-chan struct{}
+	current() any
+}
+
+// actorCell is the internal implementation of an actor with a state of type S.
+type actorCell[S, M any] struct {
+	supervisor *Supervisor
+	initial    S
+	behavior   Behavior[S, M]
+	state      S
+	published  atomic.Pointer[S]
+	lock       sync.Mutex
+	mailbox    []M
+	scheduled  bool
+	stopped    bool
+	stopping   chan struct{}
+}
+
+// Spawn starts a new actor supervised by the Supervisor, with the initial state and the behaviour passed as parameter.
+// the actor is already stopped if the Supervisor is stopped.
+func Spawn[S, M any](supervisor *Supervisor, initial S, behavior Behavior[S, M]) Actor[M] {
+	actor := &actorCell[S, M]{
+		supervisor: supervisor,
+		initial:    initial,
+		behavior:   behavior,
+		state:      initial,
+		stopping:   make(chan struct{}),
+	}
+	actor.published.Store(&initial)
+	if !supervisor.add(actor) {
+		actor.stop()
+	}
+	return Actor[M]{cell: actor}
+}
+
+// Ask sends the message built with a new Promise to the actor and returns its Future, which the actor should complete
+// as a reply.
+// the Future fails with ErrActorStopped if the actor is stopped, or with ErrAskTimeout if it's not completed in time,
+// the timeout being scheduled on the Dispatcher of the actor.
+func Ask[M, R any](actor Actor[M], message func(reply *concurrent.Promise[R]) M, timeout time.Duration) concurrent.Future[R] {
+	reply := concurrent.NewPromise[R]()
+	if !actor.Tell(message(reply)) {
+		reply.Failure(ErrActorStopped)
+		return reply.Future()
+	}
+	cancel := actor.cell.dispatcher().Schedule(timeout, func() {
+		reply.Failure(ErrAskTimeout)
+	})
+	go func() {
+		select {
+		case <-reply.Future().Done():
+		case <-actor.Done():
+			reply.Failure(ErrActorStopped)
+		}
+		cancel()
+	}()
+	return reply.Future()
+}
+
+// StateOf returns an Option containing the state of the actor after the last message it processed.
+// an empty Option is returned if the state of the actor isn't of type S.
+func StateOf[S, M any](actor Actor[M]) control.Option[S] {
+	if actor.cell == nil {
+		return control.Empty[S]()
+	}
+	state, ok := actor.cell.current().(S)
+	if !ok {
+		return control.Empty[S]()
+	}
+	return control.Of(state)
+}
+
+// Tell sends the message to the actor without waiting for it to be processed.
+// it returns false if the actor is stopped, in which case the message is dropped.
+func (a Actor[M]) Tell(message M) bool {
+	return a.cell != nil && a.cell.tell(message)
+}
+
+// Stop stops the actor, the message being processed if any is completed and the pending ones are dropped.
+func (a Actor[M]) Stop() {
+	if a.cell != nil {
+		a.cell.stop()
+	}
+}
+
+// Done returns a channel closed once the actor is stopped.
+func (a Actor[M]) Done() <-chan struct{} {
+	if a.cell == nil {
+		return stoppedChannel
+	}
+	return a.cell.done()
+}
+
+// stoppedChannel is the channel returned by Done for the zero value of Actor.
+var stoppedChannel = func() chan struct{} {
+	channel := make(chan struct{})
+	close(channel)
+	return channel
+}()
+
+// tell is an internal function queuing the message and scheduling a step if none is scheduled.
+// the step is submitted once the lock is released, since the Dispatcher may run it synchronously.
+func (c *actorCell[S, M]) tell(message M) bool {
+	c.lock.Lock()
+	if c.stopped {
+		c.lock.Unlock()
+		return false
+	}
+	c.mailbox = append(c.mailbox, message)
+	schedule := !c.scheduled
+	c.scheduled = true
+	c.lock.Unlock()
+	if schedule {
+		c.supervisor.dispatcher.Execute(c.process)
+	}
+	return true
+}
+
+// process is the internal step processing the oldest message of the mailbox and scheduling the next step if needed.
+func (c *actorCell[S, M]) process() {
+	c.lock.Lock()
+	if c.stopped || len(c.mailbox) == 0 {
+		c.scheduled = false
+		c.lock.Unlock()
+		return
+	}
+	message := c.mailbox[0]
+	c.mailbox = c.mailbox[1:]
+	c.lock.Unlock()
+
+	if state, err := c.apply(message).OrElseCause(); err != nil {
+		c.supervise(err)
+	} else {
+		c.update(state)
+	}
+
+	c.lock.Lock()
+	if c.stopped || len(c.mailbox) == 0 {
+		c.scheduled = false
+		c.lock.Unlock()
+		return
+	}
+	c.lock.Unlock()
+	c.supervisor.dispatcher.Execute(c.process)
+}
+
+// apply is an internal function applying the behaviour, turning a panic into a failure with a concurrent.PanicError cause.
+func (c *actorCell[S, M]) apply(message M) (result control.Try[S]) {
+	defer func() {
+		if value := recover(); value != nil {
+			result = control.FailureOf[S](&concurrent.PanicError{Value: value, Stack: debug.Stack()})
+		}
+	}()
+	return c.behavior(c.state, message)
+}
+
+// supervise is an internal function applying the Directive of the Strategy of the Supervisor for the cause of a failure.
+func (c *actorCell[S, M]) supervise(cause error) {
+	switch c.supervisor.strategy(cause) {
+	case Restart:
+		c.update(c.initial)
+	case Stop:
+		c.stop()
+	}
+}
+
+// update is an internal function replacing the state of the actor and publishing it for StateOf.
+func (c *actorCell[S, M]) update(state S) {
+	c.state = state
+	c.published.Store(&state)
+}
+
+// stop is an internal function stopping the actor and dropping its pending messages.
+func (c *actorCell[S, M]) stop() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.stopped {
+		return
+	}
+	c.stopped = true
+	c.mailbox = nil
+	close(c.stopping)
+	c.supervisor.remove(c)
+}
+
+// dispatcher is an internal function returning the Dispatcher of the actor.
+func (c *actorCell[S, M]) dispatcher() Dispatcher {
+	return c.supervisor.dispatcher
+}
+
+// done is an internal function returning the channel closed once the actor is stopped.
+func (c *actorCell[S, M]) done() <-chan struct{} {
+	return c.stopping
+}
+
+// current is an internal function returning the last published state of the actor.
+func (c *actorCell[S, M]) current() any {
+	return *c.published.Load()
+}
@@ -0,0 +1,151 @@
+package actor
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"glours/go2funk/api/collection"
+	"glours/go2funk/api/concurrent"
+	"glours/go2funk/api/control"
+	"gotest.tools/v3/assert"
+)
+
+var errRejected = errors.New("rejected")
+
+// counterMessage is the message of the counter actor used by the tests, whose state is the List of the increments received.
+type counterMessage struct {
+	increment int
+	fail      bool
+	panics    bool
+	reply     *concurrent.Promise[int]
+}
+
+func counter(state collection.List[int], message counterMessage) control.Try[collection.List[int]] {
+	switch {
+	case message.panics:
+		panic("unexpected message")
+	case message.fail:
+		return control.FailureOf[collection.List[int]](errRejected)
+	case message.reply != nil:
+		message.reply.Success(collection.Sum(state))
+		return control.SuccessOf(state)
+	}
+	return control.SuccessOf(state.Append(message.increment))
+}
+
+func total(reply *concurrent.Promise[int]) counterMessage {
+	return counterMessage{reply: reply}
+}
+
+func await[A any](t *testing.T, future concurrent.Future[A]) control.Try[A] {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return future.Await(ctx)
+}
+
+func TestActorProcessesMessagesInOrder(t *testing.T) {
+	var dispatcher ManualDispatcher
+	supervisor := NewSupervisor(&dispatcher, Always(Resume))
+	actor := Spawn(supervisor, collection.Empty[int](), counter)
+
+	for i := 1; i <= 3; i++ {
+		assert.Assert(t, actor.Tell(counterMessage{increment: i}))
+	}
+	assert.Equal(t, dispatcher.Pending(), 1, "an actor should schedule a single step at a time")
+	state := StateOf[collection.List[int]](actor)
+	assert.Assert(t, state.OrElse(collection.Of(-1)).IsEmpty(), "messages should not be processed before the dispatcher runs")
+
+	assert.Equal(t, dispatcher.RunUntilIdle(), 3)
+	state = StateOf[collection.List[int]](actor)
+	assert.Assert(t, state.OrElse(collection.Empty[int]()).Equals(collection.OfSlice([]int{1, 2, 3})), "unexpected state %v", state)
+	assert.Equal(t, StateOf[string](actor), control.Empty[string]())
+}
+
+func TestAsk(t *testing.T) {
+	var dispatcher ManualDispatcher
+	actor := Spawn(NewSupervisor(&dispatcher, Always(Resume)), collection.OfSlice([]int{1, 2}), counter)
+
+	future := Ask(actor, total, time.Minute)
+	assert.Assert(t, !future.IsCompleted())
+	dispatcher.RunUntilIdle()
+	assert.Equal(t, await(t, future), control.SuccessOf(3))
+
+	timedOut := Ask(actor, func(*concurrent.Promise[int]) counterMessage { return counterMessage{increment: 1} }, 10*time.Millisecond)
+	dispatcher.RunUntilIdle()
+	assert.Equal(t, dispatcher.Advance(9*time.Millisecond), 0)
+	assert.Assert(t, !timedOut.IsCompleted(), "Ask should not time out before its timeout elapsed")
+	assert.Equal(t, dispatcher.Advance(time.Millisecond), 1)
+	dispatcher.RunUntilIdle()
+	assert.Equal(t, await(t, timedOut), control.FailureOf[int](ErrAskTimeout))
+
+	pending := Ask(actor, total, time.Minute)
+	actor.Stop()
+	assert.Equal(t, await(t, pending), control.FailureOf[int](ErrActorStopped))
+	assert.Equal(t, await(t, Ask(actor, total, time.Minute)), control.FailureOf[int](ErrActorStopped))
+}
+
+// synchronousDispatcher runs the steps as soon as they are submitted.
+type synchronousDispatcher struct {
+	ManualDispatcher
+}
+
+func (*synchronousDispatcher) Execute(step func()) {
+	step()
+}
+
+func TestActorWithSynchronousDispatcher(t *testing.T) {
+	var self Actor[int]
+	forwarder := func(state collection.List[int], message int) control.Try[collection.List[int]] {
+		if message > 0 {
+			self.Tell(message - 1)
+		}
+		return control.SuccessOf(state.Append(message))
+	}
+	dispatcher := &synchronousDispatcher{}
+	self = Spawn(NewSupervisor(dispatcher, Always(Resume)), collection.Empty[int](), forwarder)
+	assert.Assert(t, self.Tell(3))
+	state := StateOf[collection.List[int]](self)
+	assert.Assert(t, state.OrElse(collection.Empty[int]()).Equals(collection.OfSlice([]int{3, 2, 1, 0})), "unexpected state %v", state)
+
+	actor := Spawn(NewSupervisor(dispatcher, Always(Resume)), collection.OfSlice([]int{1, 2}), counter)
+	assert.Equal(t, await(t, Ask(actor, total, time.Minute)), control.SuccessOf(3))
+}
+
+func TestStoppedActor(t *testing.T) {
+	var zero Actor[counterMessage]
+	assert.Assert(t, !zero.Tell(counterMessage{}))
+	<-zero.Done()
+	zero.Stop()
+	assert.Equal(t, StateOf[collection.List[int]](zero), control.Empty[collection.List[int]]())
+
+	var dispatcher ManualDispatcher
+	actor := Spawn(NewSupervisor(&dispatcher, Always(Resume)), collection.Empty[int](), counter)
+	actor.Tell(counterMessage{increment: 1})
+	actor.Stop()
+	<-actor.Done()
+	assert.Assert(t, !actor.Tell(counterMessage{increment: 2}))
+	dispatcher.RunUntilIdle()
+	state := StateOf[collection.List[int]](actor)
+	assert.Assert(t, state.OrElse(collection.Of(-1)).IsEmpty(), "pending messages should be dropped, got %v", state)
+}
+
+func TestActorsWithGoroutineDispatcher(t *testing.T) {
+	actor := Spawn(NewSupervisor(GoroutineDispatcher{}, Always(Resume)), collection.Empty[int](), counter)
+	var group sync.WaitGroup
+	for sender := 0; sender < 8; sender++ {
+		group.Add(1)
+		go func() {
+			defer group.Done()
+			for i := 0; i < 100; i++ {
+				actor.Tell(counterMessage{increment: 1})
+			}
+		}()
+	}
+	group.Wait()
+	assert.Equal(t, await(t, Ask(actor, total, 5*time.Second)), control.SuccessOf(800))
+	actor.Stop()
+}
@@ -0,0 +1,125 @@
+package actor
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Dispatcher executes the processing steps of the actors, each step processing a single message of an actor, and
+// schedules the timeouts of Ask.
+// the steps of an actor are never executed concurrently, as an actor submits its next step once the current one is over.
+// Execute may run the step synchronously, the actors never call it while holding a lock.
+type Dispatcher interface {
+	// Execute runs the step, possibly synchronously.
+	Execute(step func())
+	// Schedule runs the action once the delay elapsed, unless the returned function is called before to cancel it.
+	Schedule(delay time.Duration, action func()) (cancel func())
+}
+
+// GoroutineDispatcher is a Dispatcher executing each step in a new goroutine.
+type GoroutineDispatcher struct{}
+
+// Execute executes the step in a new goroutine.
+func (GoroutineDispatcher) Execute(step func()) {
+	go step()
+}
+
+// Schedule runs the action in a new goroutine once the delay elapsed on the wall clock.
+func (GoroutineDispatcher) Schedule(delay time.Duration, action func()) func() {
+	timer := time.AfterFunc(delay, action)
+	return func() {
+		timer.Stop()
+	}
+}
+
+// ManualDispatcher is a deterministic Dispatcher queuing the steps until they are explicitly run, in submission order,
+// by the goroutine calling RunNext or RunUntilIdle.
+// its time is virtual and only elapses with Advance, which queues the scheduled actions which are due as steps.
+// the zero value of a ManualDispatcher is ready to use.
+type ManualDispatcher struct {
+	lock    sync.Mutex
+	steps   []func()
+	elapsed time.Duration
+	timers  []*manualTimer
+}
+
+// manualTimer is an internal action scheduled on a ManualDispatcher.
+type manualTimer struct {
+	deadline time.Duration
+	action   func()
+}
+
+// Execute queues the step until it's run.
+func (d *ManualDispatcher) Execute(step func()) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.steps = append(d.steps, step)
+}
+
+// Schedule queues the action as a step once Advance made the delay elapse.
+func (d *ManualDispatcher) Schedule(delay time.Duration, action func()) func() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	timer := &manualTimer{deadline: d.elapsed + delay, action: action}
+	d.timers = append(d.timers, timer)
+	return func() {
+		d.lock.Lock()
+		defer d.lock.Unlock()
+		for i, scheduled := range d.timers {
+			if scheduled == timer {
+				d.timers = append(d.timers[:i], d.timers[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// Advance makes the duration elapse and queues the scheduled actions which are due as steps, by deadline then by
+// scheduling order, it returns the number of queued actions.
+func (d *ManualDispatcher) Advance(duration time.Duration) int {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.elapsed += duration
+	sort.SliceStable(d.timers, func(i, j int) bool {
+		return d.timers[i].deadline < d.timers[j].deadline
+	})
+	due := 0
+	for due < len(d.timers) && d.timers[due].deadline <= d.elapsed {
+		d.steps = append(d.steps, d.timers[due].action)
+		due++
+	}
+	d.timers = d.timers[due:]
+	return due
+}
+
+// Pending returns the number of queued steps.
+func (d *ManualDispatcher) Pending() int {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return len(d.steps)
+}
+
+// RunNext runs the oldest queued step, it returns false if there is none.
+func (d *ManualDispatcher) RunNext() bool {
+	d.lock.Lock()
+	if len(d.steps) == 0 {
+		d.lock.Unlock()
+		return false
+	}
+	step := d.steps[0]
+	d.steps = d.steps[1:]
+	d.lock.Unlock()
+	step()
+	return true
+}
+
+// RunUntilIdle runs the queued steps, including the ones they submit, until there is none left, and returns the
+// number of steps run.
+func (d *ManualDispatcher) RunUntilIdle() int {
+	count := 0
+	for d.RunNext() {
+		count++
+	}
+	return count
+}
@@ -0,0 +1,61 @@
+package actor
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestManualDispatcher(t *testing.T) {
+	var dispatcher ManualDispatcher
+	assert.Assert(t, !dispatcher.RunNext(), "the zero value should have no pending step")
+
+	var order []string
+	dispatcher.Execute(func() {
+		order = append(order, "first")
+		dispatcher.Execute(func() { order = append(order, "submitted by first") })
+	})
+	dispatcher.Execute(func() { order = append(order, "second") })
+	assert.Equal(t, dispatcher.Pending(), 2)
+	assert.Equal(t, len(order), 0, "steps should not run before being explicitly run")
+
+	assert.Assert(t, dispatcher.RunNext())
+	assert.DeepEqual(t, order, []string{"first"})
+	assert.Equal(t, dispatcher.RunUntilIdle(), 2)
+	assert.DeepEqual(t, order, []string{"first", "second", "submitted by first"})
+	assert.Equal(t, dispatcher.Pending(), 0)
+}
+
+func TestManualDispatcherSchedule(t *testing.T) {
+	var dispatcher ManualDispatcher
+	var order []string
+	dispatcher.Schedule(2*time.Second, func() { order = append(order, "after 2s") })
+	dispatcher.Schedule(time.Second, func() { order = append(order, "after 1s") })
+	cancel := dispatcher.Schedule(time.Second, func() { order = append(order, "cancelled") })
+	dispatcher.Schedule(time.Second, func() { order = append(order, "also after 1s") })
+	cancel()
+
+	assert.Equal(t, dispatcher.Advance(999*time.Millisecond), 0)
+	assert.Equal(t, dispatcher.Advance(time.Millisecond), 2)
+	assert.Equal(t, len(order), 0, "due actions should be queued as steps")
+	assert.Equal(t, dispatcher.RunUntilIdle(), 2)
+	assert.DeepEqual(t, order, []string{"after 1s", "also after 1s"})
+
+	assert.Equal(t, dispatcher.Advance(time.Hour), 1)
+	dispatcher.RunUntilIdle()
+	assert.DeepEqual(t, order, []string{"after 1s", "also after 1s", "after 2s"})
+}
+
+func TestGoroutineDispatcher(t *testing.T) {
+	done := make(chan struct{})
+	GoroutineDispatcher{}.Execute(func() { close(done) })
+	<-done
+
+	scheduled := make(chan struct{})
+	GoroutineDispatcher{}.Schedule(time.Millisecond, func() { close(scheduled) })
+	<-scheduled
+	cancel := GoroutineDispatcher{}.Schedule(time.Millisecond, func() { t.Error("a cancelled action should not run") })
+	cancel()
+	time.Sleep(5 * time.Millisecond)
+}
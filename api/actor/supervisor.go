@@ -0,0 +1,82 @@
+package actor
+
+import "sync"
+
+// Directive is the decision of a Supervisor when the behaviour of one of its actors fails.
+type Directive int
+
+const (
+	// Resume keeps the state the actor had before processing the failed message.
+	Resume Directive = iota
+	// Restart resets the state of the actor to its initial state.
+	Restart
+	// Stop stops the actor, its pending messages are dropped.
+	Stop
+)
+
+// Strategy returns the Directive applied to an actor whose behaviour failed with the cause passed as parameter.
+type Strategy func(cause error) Directive
+
+// Always returns a Strategy applying the same Directive whatever the cause of the failure.
+func Always(directive Directive) Strategy {
+	return func(error) Directive {
+		return directive
+	}
+}
+
+// Supervisor spawns actors on a Dispatcher and decides with its Strategy what happens to them when their behaviour fails.
+// NewSupervisor should be used to build a Supervisor.
+type Supervisor struct {
+	dispatcher Dispatcher
+	strategy   Strategy
+	lock       sync.Mutex
+	children   map[stopper]struct{}
+	stopped    bool
+}
+
+// stopper is the internal view of the actors supervised by a Supervisor.
+type stopper interface {
+	stop()
+}
+
+// NewSupervisor returns a new Supervisor running its actors on the Dispatcher and supervising them with the Strategy.
+func NewSupervisor(dispatcher Dispatcher, strategy Strategy) *Supervisor {
+	return &Supervisor{dispatcher: dispatcher, strategy: strategy, children: map[stopper]struct{}{}}
+}
+
+// Stop stops all the actors of the Supervisor, the actors spawned afterwards are stopped immediately.
+func (s *Supervisor) Stop() {
+	s.lock.Lock()
+	s.stopped = true
+	children := s.children
+	s.children = map[stopper]struct{}{}
+	s.lock.Unlock()
+	for child := range children {
+		child.stop()
+	}
+}
+
+// Children returns the number of running actors of the Supervisor.
+func (s *Supervisor) Children() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return len(s.children)
+}
+
+// add is an internal function registering a new actor, it returns false if the Supervisor is stopped.
+func (s *Supervisor) add(child stopper) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.stopped {
+		return false
+	}
+	s.children[child] = struct{}{}
+	return true
+}
+
+// remove is an internal function unregistering a stopped actor.
+func (s *Supervisor) remove(child stopper) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.children, child)
+}
@@ -0,0 +1,77 @@
+package actor
+
+import (
+	"errors"
+	"testing"
+
+	"glours/go2funk/api/collection"
+	"glours/go2funk/api/concurrent"
+	"glours/go2funk/api/control"
+	"gotest.tools/v3/assert"
+)
+
+func TestSupervisionDirectives(t *testing.T) {
+	testCases := []struct {
+		name     string
+		strategy Strategy
+		failure  counterMessage
+		expected collection.List[int]
+		stopped  bool
+	}{
+		{name: "Resume", strategy: Always(Resume), failure: counterMessage{fail: true}, expected: collection.OfSlice([]int{1, 2})},
+		{name: "Restart", strategy: Always(Restart), failure: counterMessage{fail: true}, expected: collection.Of(2)},
+		{name: "Stop", strategy: Always(Stop), failure: counterMessage{fail: true}, expected: collection.Of(1), stopped: true},
+		{name: "Restart on panic", strategy: Always(Restart), failure: counterMessage{panics: true}, expected: collection.Of(2)},
+		{
+			name: "Strategy depending on the cause",
+			strategy: func(cause error) Directive {
+				var panicError *concurrent.PanicError
+				if errors.As(cause, &panicError) {
+					return Stop
+				}
+				return Resume
+			},
+			failure:  counterMessage{panics: true},
+			expected: collection.Of(1),
+			stopped:  true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var dispatcher ManualDispatcher
+			supervisor := NewSupervisor(&dispatcher, testCase.strategy)
+			actor := Spawn(supervisor, collection.Empty[int](), counter)
+			actor.Tell(counterMessage{increment: 1})
+			actor.Tell(testCase.failure)
+			actor.Tell(counterMessage{increment: 2})
+			dispatcher.RunUntilIdle()
+
+			state := StateOf[collection.List[int]](actor).OrElse(collection.Empty[int]())
+			assert.Assert(t, state.Equals(testCase.expected), "expected %v but state is %v", testCase.expected, state)
+			select {
+			case <-actor.Done():
+				assert.Assert(t, testCase.stopped, "the actor should not be stopped")
+			default:
+				assert.Assert(t, !testCase.stopped, "the actor should be stopped")
+			}
+		})
+	}
+}
+
+func TestSupervisorStop(t *testing.T) {
+	var dispatcher ManualDispatcher
+	supervisor := NewSupervisor(&dispatcher, Always(Resume))
+	first := Spawn(supervisor, collection.Empty[int](), counter)
+	second := Spawn(supervisor, 0, func(state int, message string) control.Try[int] { return control.SuccessOf(state + 1) })
+	assert.Equal(t, supervisor.Children(), 2)
+
+	first.Stop()
+	assert.Equal(t, supervisor.Children(), 1)
+
+	supervisor.Stop()
+	<-second.Done()
+	assert.Equal(t, supervisor.Children(), 0)
+	late := Spawn(supervisor, 0, func(state int, message string) control.Try[int] { return control.SuccessOf(state) })
+	<-late.Done()
+	assert.Assert(t, !late.Tell("ignored"))
+}
@@ -0,0 +1,107 @@
+package collection
+
+import (
+	"runtime"
+	"sync/atomic"
+)
+
+// ListBuilder is a transient, mutable builder of List owned by the goroutine which created it.
+// elements are linked in place, so building a List of n elements is O(n), and Freeze turns it into a persistent List in O(1).
+// a ListBuilder panics if it is used after being frozen or by another goroutine than its owner.
+// checking the owner costs a few microseconds per call, AppendAll and AppendList should be preferred to add many elements.
+type ListBuilder[T any] struct {
+	cells  appender[T]
+	owner  uint64
+	frozen bool
+	busy   atomic.Bool
+}
+
+// NewListBuilder returns an empty ListBuilder for elements of type T.
+func NewListBuilder[T any]() *ListBuilder[T] {
+	return &ListBuilder[T]{owner: goroutineID()}
+}
+
+// Append adds the value passed as parameter at the end of the List being built.
+func (b *ListBuilder[T]) Append(value T) *ListBuilder[T] {
+	b.acquire()
+	defer b.release()
+	b.cells.add(value)
+	return b
+}
+
+// AppendAll adds the values passed as parameter at the end of the List being built.
+func (b *ListBuilder[T]) AppendAll(values ...T) *ListBuilder[T] {
+	b.acquire()
+	defer b.release()
+	for _, value := range values {
+		b.cells.add(value)
+	}
+	return b
+}
+
+// AppendList adds the elements of the List passed as parameter at the end of the List being built.
+func (b *ListBuilder[T]) AppendList(list List[T]) *ListBuilder[T] {
+	b.acquire()
+	defer b.release()
+	for current := list.first; current != nil; current = current.next {
+		b.cells.add(current.value)
+	}
+	return b
+}
+
+// Length returns the number of elements added to the builder.
+func (b *ListBuilder[T]) Length() int {
+	b.acquire()
+	defer b.release()
+	return b.cells.length
+}
+
+// Freeze returns the List built so far without copying it and invalidates the builder.
+// any later use of the builder panics.
+func (b *ListBuilder[T]) Freeze() List[T] {
+	b.acquire()
+	defer b.release()
+	b.frozen = true
+	return b.cells.result(Empty[T]())
+}
+
+// acquire is an internal function guarding the builder against use after freezing and use by another goroutine than
+// its owner, the first goroutine using the zero value of a ListBuilder becomes its owner.
+// release should be called once the operation is done.
+func (b *ListBuilder[T]) acquire() {
+	if !b.busy.CompareAndSwap(false, true) {
+		panic("collection: ListBuilder used concurrently by several goroutines")
+	}
+	if b.frozen {
+		b.busy.Store(false)
+		panic("collection: ListBuilder used after Freeze")
+	}
+	id := goroutineID()
+	if b.owner == 0 {
+		b.owner = id
+	}
+	if b.owner != id {
+		b.busy.Store(false)
+		panic("collection: ListBuilder used by another goroutine than its owner")
+	}
+}
+
+// release is an internal function marking the builder as available again.
+func (b *ListBuilder[T]) release() {
+	b.busy.Store(false)
+}
+
+// goroutineID is an internal function returning the identifier of the current goroutine, parsed from the header of its
+// stack trace "goroutine 42 [running]:" since Go doesn't expose it.
+func goroutineID() uint64 {
+	var header [64]byte
+	length := runtime.Stack(header[:], false)
+	var id uint64
+	for _, digit := range header[len("goroutine "):length] {
+		if digit < '0' || digit > '9' {
+			break
+		}
+		id = id*10 + uint64(digit-'0')
+	}
+	return id
+}
@@ -0,0 +1,117 @@
+package collection
+
+import (
+	"fmt"
+	"gotest.tools/v3/assert"
+	"testing"
+)
+
+func TestListBuilder(t *testing.T) {
+	builder := NewListBuilder[int]().Append(1).AppendAll(2, 3).AppendList(OfSlice([]int{4, 5}))
+	assert.Equal(t, builder.Length(), 5)
+
+	result := builder.Freeze()
+	assert.Assert(t, result.Equals(multipleElementsList), fmt.Sprintf("expected %v but value is %v", multipleElementsList, result))
+	assert.Equal(t, result.Length(), 5)
+}
+
+func TestEmptyListBuilder(t *testing.T) {
+	result := NewListBuilder[int]().Freeze()
+	assert.Assert(t, result.IsEmpty(), "result should be an empty List")
+}
+
+func TestFrozenListBuilderPanics(t *testing.T) {
+	builder := NewListBuilder[int]().Append(1)
+	frozen := builder.Freeze()
+
+	testCases := []struct {
+		name string
+		use  func()
+	}{
+		{name: "Append", use: func() { builder.Append(2) }},
+		{name: "AppendAll", use: func() { builder.AppendAll(2, 3) }},
+		{name: "AppendList", use: func() { builder.AppendList(frozen) }},
+		{name: "Length", use: func() { builder.Length() }},
+		{name: "Freeze", use: func() { builder.Freeze() }},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, recoverPanic(testCase.use), "collection: ListBuilder used after Freeze")
+		})
+	}
+	assert.Assert(t, frozen.Equals(Of(1)), "frozen List should not be modified")
+}
+
+func TestConcurrentListBuilderPanics(t *testing.T) {
+	builder := NewListBuilder[int]()
+	// simulate another goroutine being in the middle of an operation
+	builder.busy.Store(true)
+	assert.Equal(t, recoverPanic(func() { builder.Append(1) }), "collection: ListBuilder used concurrently by several goroutines")
+
+	builder.busy.Store(false)
+	assert.Equal(t, builder.Append(1).Length(), 1)
+}
+
+func TestListBuilderUsedByAnotherGoroutinePanics(t *testing.T) {
+	builder := NewListBuilder[int]().Append(1)
+	recovered := make(chan any)
+	go func() {
+		recovered <- recoverPanic(func() { builder.Append(2) })
+	}()
+	assert.Equal(t, <-recovered, "collection: ListBuilder used by another goroutine than its owner")
+	assert.Assert(t, builder.Freeze().Equals(Of(1)), "the builder should not be modified by another goroutine")
+}
+
+func TestZeroValueListBuilderIsOwnedByItsFirstUser(t *testing.T) {
+	builder := &ListBuilder[int]{}
+	recovered := make(chan any)
+	go func() {
+		builder.Append(1)
+		recovered <- recoverPanic(func() { builder.Append(2) })
+	}()
+	assert.Equal(t, <-recovered, nil, "the first user of the zero value should own it")
+	assert.Equal(t, recoverPanic(func() { builder.Append(3) }), "collection: ListBuilder used by another goroutine than its owner")
+}
+
+func BenchmarkListBuilder(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		builder := NewListBuilder[int]()
+		for j := 0; j < 1000; j++ {
+			builder.Append(j)
+		}
+		listSink = builder.Freeze()
+	}
+}
+
+func BenchmarkListBuilderAppendAll(b *testing.B) {
+	values := make([]int, 1000)
+	for j := range values {
+		values[j] = j
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		listSink = NewListBuilder[int]().AppendAll(values...).Freeze()
+	}
+}
+
+func BenchmarkRepeatedAppend(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		list := Empty[int]()
+		for j := 0; j < 1000; j++ {
+			list = list.Append(j)
+		}
+		listSink = list
+	}
+}
+
+// recoverPanic runs the function passed as parameter and returns the value it panicked with, if any.
+func recoverPanic(f func()) (recovered any) {
+	defer func() {
+		recovered = recover()
+	}()
+	f()
+	return nil
+}
@@ -0,0 +1,615 @@
+package collection
+
+import (
+	"math/bits"
+	"reflect"
+	"sync/atomic"
+
+	"github.com/mitchellh/hashstructure/v2"
+	"glours/go2funk/api/control"
+)
+
+const (
+	// ctrieBits is the number of bits of the hash consumed by each level of a Ctrie.
+	ctrieBits = 5
+	// ctrieMask selects the bits of the hash used at a given level of a Ctrie.
+	ctrieMask = 1<<ctrieBits - 1
+	// ctrieHashBits is the number of bits of the hash of a key, keys with the same hash share a collision list.
+	ctrieHashBits = 64
+)
+
+// Ctrie is a concurrent hash trie, a map whose reads and writes are lock-free and which provides constant time
+// consistent snapshots, as described by Prokopec, Bronson, Bagwell and Odersky in
+// "Concurrent Tries with Efficient Non-Blocking Snapshots".
+// NewCtrie or NewCtrieWithHash should be used to build a Ctrie.
+type Ctrie[K comparable, V any] struct {
+	root     atomic.Pointer[ctrieRoot[K, V]]
+	hash     func(K) uint64
+	readOnly bool
+}
+
+// CtrieSnapshot is a read-only persistent view of a Ctrie at the time it was taken, unaffected by later writes.
+// iterating over a snapshot doesn't block the writers of the Ctrie.
+type CtrieSnapshot[K comparable, V any] struct {
+	TraversableOps[Entry[K, V]]
+	trie *Ctrie[K, V]
+}
+
+var _ Traversable[Entry[int, int]] = CtrieSnapshot[int, int]{}
+
+// generation identifies the nodes of a Ctrie created between two snapshots, nodes of an older generation are copied
+// before being modified.
+// it's never empty, so that each generation has its own address.
+type generation struct {
+	_ byte
+}
+
+// ctrieRoot is the internal root of a Ctrie, either an indirection node or a descriptor of a snapshot in progress.
+type ctrieRoot[K comparable, V any] struct {
+	inode      *iNode[K, V]
+	descriptor *rdcssDescriptor[K, V]
+}
+
+// rdcssDescriptor describes the replacement of the root of a Ctrie by a snapshot, which succeeds only if the main
+// node of the old root is still the expected one.
+type rdcssDescriptor[K comparable, V any] struct {
+	old       *ctrieRoot[K, V]
+	expected  *mainNode[K, V]
+	new       *ctrieRoot[K, V]
+	committed atomic.Bool
+}
+
+// branch is implemented by the children of a cNode, an iNode or a sNode.
+type branch[K comparable, V any] interface {
+	isBranch()
+}
+
+// iNode is an indirection node, the only mutable node of a Ctrie, pointing to its current main node.
+type iNode[K comparable, V any] struct {
+	main atomic.Pointer[mainNode[K, V]]
+	gen  *generation
+}
+
+// mainNode is the node pointed by an iNode: a cNode, a tombed sNode, a collision list, or a failed wrapper of a previous
+// main node when used as the prev of a main node whose installation failed.
+// prev is the main node replaced by the current one until its installation is committed.
+type mainNode[K comparable, V any] struct {
+	cnode  *cNode[K, V]
+	tomb   *sNode[K, V]
+	lnode  *lNode[K, V]
+	failed *mainNode[K, V]
+	prev   atomic.Pointer[mainNode[K, V]]
+}
+
+// cNode is a branching node containing the children whose hash matches the bits of its bitmap at its level.
+type cNode[K comparable, V any] struct {
+	bitmap uint32
+	array  []branch[K, V]
+	gen    *generation
+}
+
+// sNode is a leaf of a Ctrie holding an Entry and the hash of its key.
+type sNode[K comparable, V any] struct {
+	entry Entry[K, V]
+	hash  uint64
+}
+
+// lNode is a list of the entries whose keys have the same hash.
+type lNode[K comparable, V any] struct {
+	entries List[Entry[K, V]]
+	hash    uint64
+}
+
+func (*iNode[K, V]) isBranch() {}
+
+func (*sNode[K, V]) isBranch() {}
+
+// NewCtrie returns an empty Ctrie hashing its keys with hashstructure, except pointer keys which are hashed by identity
+// since hashstructure hashes the values they point to.
+// keys containing pointers, such as structs or interfaces, are still hashed through the values they point to, which must
+// not change while the keys are in the Ctrie, NewCtrieWithHash should be used for such keys.
+// all the keys which can't be hashed by hashstructure, such as channels, share the same hash.
+func NewCtrie[K comparable, V any]() *Ctrie[K, V] {
+	if kind := reflect.TypeOf((*K)(nil)).Elem().Kind(); kind == reflect.Pointer || kind == reflect.UnsafePointer {
+		return NewCtrieWithHash[K, V](func(key K) uint64 {
+			return mixAddress(uint64(reflect.ValueOf(key).Pointer()))
+		})
+	}
+	return NewCtrieWithHash[K, V](func(key K) uint64 {
+		hash, err := hashstructure.Hash(key, hashstructure.FormatV2, nil)
+		if err != nil {
+			return 0
+		}
+		return hash
+	})
+}
+
+// mixAddress is an internal function spreading the bits of an address, whose lowest bits are always zero because of
+// alignment, over the whole hash with the finalizer of SplitMix64.
+func mixAddress(address uint64) uint64 {
+	address = (address ^ address>>30) * 0xbf58476d1ce4e5b9
+	address = (address ^ address>>27) * 0x94d049bb133111eb
+	return address ^ address>>31
+}
+
+// NewCtrieWithHash returns an empty Ctrie hashing its keys with the function passed as parameter.
+// equal keys must have the same hash.
+func NewCtrieWithHash[K comparable, V any](hash func(K) uint64) *Ctrie[K, V] {
+	gen := &generation{}
+	root := &iNode[K, V]{gen: gen}
+	root.main.Store(&mainNode[K, V]{cnode: &cNode[K, V]{gen: gen}})
+	trie := &Ctrie[K, V]{hash: hash}
+	trie.root.Store(&ctrieRoot[K, V]{inode: root})
+	return trie
+}
+
+// Get returns an Option containing the value associated to the key passed as parameter.
+func (c *Ctrie[K, V]) Get(key K) control.Option[V] {
+	hash := c.hash(key)
+	for {
+		root := c.readRoot(false)
+		if result, ok := c.lookup(root, key, hash, 0, nil, root.gen); ok {
+			return result
+		}
+	}
+}
+
+// Put associates the value passed as parameter to the key, replacing the previous value if any.
+func (c *Ctrie[K, V]) Put(key K, value V) {
+	if c.readOnly {
+		panic("collection: Put on a read-only Ctrie")
+	}
+	leaf := &sNode[K, V]{entry: NewEntry[K, V](key, value, nil), hash: c.hash(key)}
+	for {
+		root := c.readRoot(false)
+		if c.insert(root, leaf, 0, nil, root.gen) {
+			return
+		}
+	}
+}
+
+// Remove removes the key passed as parameter and returns an Option containing the value it was associated to.
+func (c *Ctrie[K, V]) Remove(key K) control.Option[V] {
+	if c.readOnly {
+		panic("collection: Remove on a read-only Ctrie")
+	}
+	hash := c.hash(key)
+	for {
+		root := c.readRoot(false)
+		if result, ok := c.remove(root, key, hash, 0, nil, root.gen); ok {
+			return result
+		}
+	}
+}
+
+// Snapshot returns a consistent read-only view of the current Ctrie in constant time.
+// the nodes are shared and lazily copied by the writers of the Ctrie, which are never blocked by the snapshot.
+func (c *Ctrie[K, V]) Snapshot() CtrieSnapshot[K, V] {
+	if c.readOnly {
+		return CtrieSnapshot[K, V]{NewTraversableOps(c.forEachWhile), c}
+	}
+	for {
+		current := c.root.Load()
+		if current.descriptor != nil {
+			c.completeRoot(false)
+			continue
+		}
+		expected := c.read(current.inode)
+		renewed := &ctrieRoot[K, V]{inode: c.copyToGen(current.inode, &generation{})}
+		if c.swapRoot(current, expected, renewed) {
+			snapshot := &Ctrie[K, V]{hash: c.hash, readOnly: true}
+			snapshot.root.Store(&ctrieRoot[K, V]{inode: current.inode})
+			return CtrieSnapshot[K, V]{NewTraversableOps(snapshot.forEachWhile), snapshot}
+		}
+	}
+}
+
+// Get returns an Option containing the value associated to the key passed as parameter in the snapshot.
+func (s CtrieSnapshot[K, V]) Get(key K) control.Option[V] {
+	if s.trie == nil {
+		return control.Empty[V]()
+	}
+	return s.trie.Get(key)
+}
+
+// forEachWhile is an internal function calling the function on each entry of a read-only Ctrie until it returns false.
+func (c *Ctrie[K, V]) forEachWhile(f func(Entry[K, V]) bool) {
+	c.iterate(c.readRoot(false), f)
+}
+
+// iterate is an internal function calling the function on each entry reachable from the iNode until it returns false.
+func (c *Ctrie[K, V]) iterate(in *iNode[K, V], f func(Entry[K, V]) bool) bool {
+	main := c.read(in)
+	switch {
+	case main.cnode != nil:
+		for _, child := range main.cnode.array {
+			switch child := child.(type) {
+			case *iNode[K, V]:
+				if !c.iterate(child, f) {
+					return false
+				}
+			case *sNode[K, V]:
+				if !f(child.entry) {
+					return false
+				}
+			}
+		}
+		return true
+	case main.tomb != nil:
+		return f(main.tomb.entry)
+	default:
+		completed := true
+		main.lnode.entries.ForEachWhile(func(entry Entry[K, V]) bool {
+			completed = f(entry)
+			return completed
+		})
+		return completed
+	}
+}
+
+// lookup is an internal function searching the key from the iNode at the level, it returns false if the operation
+// should be restarted from the root.
+func (c *Ctrie[K, V]) lookup(in *iNode[K, V], key K, hash uint64, level uint, parent *iNode[K, V], startGen *generation) (control.Option[V], bool) {
+	main := c.read(in)
+	switch {
+	case main.cnode != nil:
+		cn := main.cnode
+		flag, position := flagPosition(hash, level, cn.bitmap)
+		if cn.bitmap&flag == 0 {
+			return control.Empty[V](), true
+		}
+		switch child := cn.array[position].(type) {
+		case *iNode[K, V]:
+			if c.readOnly || child.gen == startGen {
+				return c.lookup(child, key, hash, level+ctrieBits, in, startGen)
+			}
+			if c.gcas(in, main, &mainNode[K, V]{cnode: c.renewed(cn, startGen)}) {
+				return c.lookup(in, key, hash, level, parent, startGen)
+			}
+			return control.Empty[V](), false
+		case *sNode[K, V]:
+			return child.get(key, hash), true
+		}
+	case main.tomb != nil:
+		if c.readOnly {
+			return main.tomb.get(key, hash), true
+		}
+		c.clean(parent, level-ctrieBits)
+		return control.Empty[V](), false
+	}
+	return main.lnode.get(key), true
+}
+
+// insert is an internal function inserting the leaf from the iNode at the level, it returns false if the operation
+// should be restarted from the root.
+func (c *Ctrie[K, V]) insert(in *iNode[K, V], leaf *sNode[K, V], level uint, parent *iNode[K, V], startGen *generation) bool {
+	main := c.read(in)
+	switch {
+	case main.cnode != nil:
+		cn := main.cnode
+		flag, position := flagPosition(leaf.hash, level, cn.bitmap)
+		if cn.bitmap&flag == 0 {
+			renewed := cn
+			if cn.gen != in.gen {
+				renewed = c.renewed(cn, in.gen)
+			}
+			return c.gcas(in, main, &mainNode[K, V]{cnode: renewed.inserted(position, flag, leaf, in.gen)})
+		}
+		switch child := cn.array[position].(type) {
+		case *iNode[K, V]:
+			if child.gen == startGen {
+				return c.insert(child, leaf, level+ctrieBits, in, startGen)
+			}
+			if c.gcas(in, main, &mainNode[K, V]{cnode: c.renewed(cn, startGen)}) {
+				return c.insert(in, leaf, level, parent, startGen)
+			}
+			return false
+		case *sNode[K, V]:
+			if child.hash == leaf.hash && child.entry.GetKey() == leaf.entry.GetKey() {
+				return c.gcas(in, main, &mainNode[K, V]{cnode: cn.updated(position, leaf, in.gen)})
+			}
+			renewed := cn
+			if cn.gen != in.gen {
+				renewed = c.renewed(cn, in.gen)
+			}
+			sub := &iNode[K, V]{gen: in.gen}
+			sub.main.Store(dual(child, leaf, level+ctrieBits, in.gen))
+			return c.gcas(in, main, &mainNode[K, V]{cnode: renewed.updated(position, sub, in.gen)})
+		}
+	case main.tomb != nil:
+		c.clean(parent, level-ctrieBits)
+		return false
+	}
+	return c.gcas(in, main, &mainNode[K, V]{lnode: main.lnode.inserted(leaf)})
+}
+
+// remove is an internal function removing the key from the iNode at the level, it returns false if the operation
+// should be restarted from the root.
+func (c *Ctrie[K, V]) remove(in *iNode[K, V], key K, hash uint64, level uint, parent *iNode[K, V], startGen *generation) (control.Option[V], bool) {
+	main := c.read(in)
+	switch {
+	case main.cnode != nil:
+		cn := main.cnode
+		flag, position := flagPosition(hash, level, cn.bitmap)
+		if cn.bitmap&flag == 0 {
+			return control.Empty[V](), true
+		}
+		var result control.Option[V]
+		var ok bool
+		switch child := cn.array[position].(type) {
+		case *iNode[K, V]:
+			if child.gen == startGen {
+				result, ok = c.remove(child, key, hash, level+ctrieBits, in, startGen)
+			} else if c.gcas(in, main, &mainNode[K, V]{cnode: c.renewed(cn, startGen)}) {
+				result, ok = c.remove(in, key, hash, level, parent, startGen)
+			}
+		case *sNode[K, V]:
+			result, ok = child.get(key, hash), true
+			if !result.IsEmpty() {
+				ok = c.gcas(in, main, toContracted(cn.removed(position, flag, in.gen), level))
+			}
+		}
+		if ok && !result.IsEmpty() && parent != nil && c.read(in).tomb != nil {
+			c.cleanParent(parent, in, hash, level-ctrieBits, startGen)
+		}
+		return result, ok
+	case main.tomb != nil:
+		c.clean(parent, level-ctrieBits)
+		return control.Empty[V](), false
+	}
+	result := main.lnode.get(key)
+	if result.IsEmpty() {
+		return result, true
+	}
+	return result, c.gcas(in, main, main.lnode.removed(key))
+}
+
+// clean is an internal function compressing the cNode of the iNode at the level, resurrecting its tombed children.
+func (c *Ctrie[K, V]) clean(in *iNode[K, V], level uint) {
+	if in == nil {
+		return
+	}
+	if main := c.read(in); main.cnode != nil {
+		c.gcas(in, main, c.toCompressed(main.cnode, level, in.gen))
+	}
+}
+
+// cleanParent is an internal function replacing the tombed iNode by its leaf in the cNode of its parent at the level.
+func (c *Ctrie[K, V]) cleanParent(parent *iNode[K, V], in *iNode[K, V], hash uint64, level uint, startGen *generation) {
+	for {
+		main, parentMain := c.read(in), c.read(parent)
+		if parentMain.cnode == nil || main.tomb == nil {
+			return
+		}
+		cn := parentMain.cnode
+		flag, position := flagPosition(hash, level, cn.bitmap)
+		if cn.bitmap&flag == 0 || cn.array[position] != branch[K, V](in) {
+			return
+		}
+		updated := cn.updated(position, main.tomb, in.gen)
+		if c.gcas(parent, parentMain, toContracted(updated, level)) || c.readRoot(false).gen != startGen {
+			return
+		}
+	}
+}
+
+// toCompressed is an internal function returning a copy of the cNode at the level whose tombed children are resurrected.
+func (c *Ctrie[K, V]) toCompressed(cn *cNode[K, V], level uint, gen *generation) *mainNode[K, V] {
+	array := make([]branch[K, V], len(cn.array))
+	for i, child := range cn.array {
+		array[i] = child
+		if in, ok := child.(*iNode[K, V]); ok {
+			if main := c.read(in); main.tomb != nil {
+				array[i] = main.tomb
+			}
+		}
+	}
+	return toContracted(&cNode[K, V]{bitmap: cn.bitmap, array: array, gen: gen}, level)
+}
+
+// toContracted is an internal function returning a tombed leaf for a cNode below the root containing only a leaf.
+func toContracted[K comparable, V any](cn *cNode[K, V], level uint) *mainNode[K, V] {
+	if level > 0 && len(cn.array) == 1 {
+		if leaf, ok := cn.array[0].(*sNode[K, V]); ok {
+			return &mainNode[K, V]{tomb: leaf}
+		}
+	}
+	return &mainNode[K, V]{cnode: cn}
+}
+
+// renewed is an internal function returning a copy of the cNode whose iNode children are copied to the generation.
+func (c *Ctrie[K, V]) renewed(cn *cNode[K, V], gen *generation) *cNode[K, V] {
+	array := make([]branch[K, V], len(cn.array))
+	for i, child := range cn.array {
+		array[i] = child
+		if in, ok := child.(*iNode[K, V]); ok {
+			array[i] = c.copyToGen(in, gen)
+		}
+	}
+	return &cNode[K, V]{bitmap: cn.bitmap, array: array, gen: gen}
+}
+
+// copyToGen is an internal function returning a new iNode of the generation sharing the main node of the iNode.
+func (c *Ctrie[K, V]) copyToGen(in *iNode[K, V], gen *generation) *iNode[K, V] {
+	copied := &iNode[K, V]{gen: gen}
+	copied.main.Store(c.read(in))
+	return copied
+}
+
+// read is an internal function returning the committed main node of the iNode.
+func (c *Ctrie[K, V]) read(in *iNode[K, V]) *mainNode[K, V] {
+	main := in.main.Load()
+	if main.prev.Load() == nil {
+		return main
+	}
+	return c.commit(in, main)
+}
+
+// gcas is an internal generation compare-and-swap replacing the old main node of the iNode by the new one, which is
+// only committed if the root of the Ctrie still has the generation of the iNode, so no snapshot was taken meanwhile.
+func (c *Ctrie[K, V]) gcas(in *iNode[K, V], old *mainNode[K, V], new *mainNode[K, V]) bool {
+	new.prev.Store(old)
+	if in.main.CompareAndSwap(old, new) {
+		c.commit(in, new)
+		return new.prev.Load() == nil
+	}
+	return false
+}
+
+// commit is an internal function completing the pending gcas installing the main node in the iNode, committing it or
+// restoring the previous main node, and returning the resulting main node.
+func (c *Ctrie[K, V]) commit(in *iNode[K, V], main *mainNode[K, V]) *mainNode[K, V] {
+	for {
+		prev := main.prev.Load()
+		root := c.readRoot(true)
+		switch {
+		case prev == nil:
+			return main
+		case prev.failed != nil:
+			if in.main.CompareAndSwap(main, prev.failed) {
+				return prev.failed
+			}
+			main = in.main.Load()
+		case root.gen == in.gen && !c.readOnly:
+			if main.prev.CompareAndSwap(prev, nil) {
+				return main
+			}
+		default:
+			main.prev.CompareAndSwap(prev, &mainNode[K, V]{failed: prev})
+			main = in.main.Load()
+		}
+	}
+}
+
+// readRoot is an internal function returning the root iNode, completing or aborting a pending snapshot.
+func (c *Ctrie[K, V]) readRoot(abort bool) *iNode[K, V] {
+	if root := c.root.Load(); root.descriptor == nil {
+		return root.inode
+	}
+	return c.completeRoot(abort)
+}
+
+// swapRoot is an internal restricted double compare single swap replacing the old root by the new one if the main
+// node of the old root is still the expected one.
+func (c *Ctrie[K, V]) swapRoot(old *ctrieRoot[K, V], expected *mainNode[K, V], new *ctrieRoot[K, V]) bool {
+	descriptor := &rdcssDescriptor[K, V]{old: old, expected: expected, new: new}
+	if c.root.CompareAndSwap(old, &ctrieRoot[K, V]{descriptor: descriptor}) {
+		c.completeRoot(false)
+		return descriptor.committed.Load()
+	}
+	return false
+}
+
+// completeRoot is an internal function completing, or aborting, the swap of the root described by a pending descriptor.
+func (c *Ctrie[K, V]) completeRoot(abort bool) *iNode[K, V] {
+	for {
+		root := c.root.Load()
+		if root.descriptor == nil {
+			return root.inode
+		}
+		descriptor := root.descriptor
+		if abort {
+			if c.root.CompareAndSwap(root, descriptor.old) {
+				return descriptor.old.inode
+			}
+			continue
+		}
+		if c.read(descriptor.old.inode) == descriptor.expected {
+			if c.root.CompareAndSwap(root, descriptor.new) {
+				descriptor.committed.Store(true)
+				return descriptor.new.inode
+			}
+			continue
+		}
+		if c.root.CompareAndSwap(root, descriptor.old) {
+			return descriptor.old.inode
+		}
+	}
+}
+
+// dual is an internal function returning the main node of a new iNode at the level containing the two leaves.
+func dual[K comparable, V any](first, second *sNode[K, V], level uint, gen *generation) *mainNode[K, V] {
+	if level >= ctrieHashBits {
+		return &mainNode[K, V]{lnode: &lNode[K, V]{entries: OfSlice([]Entry[K, V]{second.entry, first.entry}), hash: first.hash}}
+	}
+	firstIndex, secondIndex := (first.hash>>level)&ctrieMask, (second.hash>>level)&ctrieMask
+	bitmap := uint32(1)<<firstIndex | uint32(1)<<secondIndex
+	switch {
+	case firstIndex == secondIndex:
+		sub := &iNode[K, V]{gen: gen}
+		sub.main.Store(dual(first, second, level+ctrieBits, gen))
+		return &mainNode[K, V]{cnode: &cNode[K, V]{bitmap: bitmap, array: []branch[K, V]{sub}, gen: gen}}
+	case firstIndex < secondIndex:
+		return &mainNode[K, V]{cnode: &cNode[K, V]{bitmap: bitmap, array: []branch[K, V]{first, second}, gen: gen}}
+	default:
+		return &mainNode[K, V]{cnode: &cNode[K, V]{bitmap: bitmap, array: []branch[K, V]{second, first}, gen: gen}}
+	}
+}
+
+// flagPosition is an internal function returning the bit of the hash at the level and the position of the matching
+// child in the array of a cNode with the bitmap.
+func flagPosition(hash uint64, level uint, bitmap uint32) (uint32, int) {
+	flag := uint32(1) << ((hash >> level) & ctrieMask)
+	return flag, bits.OnesCount32(bitmap & (flag - 1))
+}
+
+// inserted is an internal function returning a copy of the cNode with the child inserted at the position.
+func (cn *cNode[K, V]) inserted(position int, flag uint32, child branch[K, V], gen *generation) *cNode[K, V] {
+	array := make([]branch[K, V], len(cn.array)+1)
+	copy(array, cn.array[:position])
+	array[position] = child
+	copy(array[position+1:], cn.array[position:])
+	return &cNode[K, V]{bitmap: cn.bitmap | flag, array: array, gen: gen}
+}
+
+// updated is an internal function returning a copy of the cNode with the child replacing the one at the position.
+func (cn *cNode[K, V]) updated(position int, child branch[K, V], gen *generation) *cNode[K, V] {
+	array := make([]branch[K, V], len(cn.array))
+	copy(array, cn.array)
+	array[position] = child
+	return &cNode[K, V]{bitmap: cn.bitmap, array: array, gen: gen}
+}
+
+// removed is an internal function returning a copy of the cNode without the child at the position.
+func (cn *cNode[K, V]) removed(position int, flag uint32, gen *generation) *cNode[K, V] {
+	array := make([]branch[K, V], len(cn.array)-1)
+	copy(array, cn.array[:position])
+	copy(array[position:], cn.array[position+1:])
+	return &cNode[K, V]{bitmap: cn.bitmap ^ flag, array: array, gen: gen}
+}
+
+// get is an internal function returning an Option containing the value of the leaf if it matches the key.
+func (sn *sNode[K, V]) get(key K, hash uint64) control.Option[V] {
+	if sn.hash == hash && sn.entry.GetKey() == key {
+		return control.Of(sn.entry.GetValue())
+	}
+	return control.Empty[V]()
+}
+
+// get is an internal function returning an Option containing the value associated to the key in the collision list.
+func (ln *lNode[K, V]) get(key K) control.Option[V] {
+	return control.MapOption(ln.entries.Find(func(entry Entry[K, V]) bool {
+		return entry.GetKey() == key
+	}), Entry[K, V].GetValue)
+}
+
+// inserted is an internal function returning a copy of the collision list with the entry of the leaf.
+func (ln *lNode[K, V]) inserted(leaf *sNode[K, V]) *lNode[K, V] {
+	key := leaf.entry.GetKey()
+	entries := ln.entries.RemovePredicate(func(entry Entry[K, V]) bool {
+		return entry.GetKey() == key
+	})
+	return &lNode[K, V]{entries: newCons(leaf.entry, entries), hash: ln.hash}
+}
+
+// removed is an internal function returning the main node replacing the collision list without the key, which is a
+// tombed leaf if only one entry is left.
+func (ln *lNode[K, V]) removed(key K) *mainNode[K, V] {
+	entries := ln.entries.RemovePredicate(func(entry Entry[K, V]) bool {
+		return entry.GetKey() == key
+	})
+	if entries.Length() == 1 {
+		return &mainNode[K, V]{tomb: &sNode[K, V]{entry: entries.first.value, hash: ln.hash}}
+	}
+	return &mainNode[K, V]{lnode: &lNode[K, V]{entries: entries, hash: ln.hash}}
+}
@@ -0,0 +1,193 @@
+package collection
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"glours/go2funk/api/control"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+)
+
+func TestCtrie(t *testing.T) {
+	testCases := []struct {
+		name  string
+		trie  func() *Ctrie[int, string]
+		count int
+	}{
+		{name: "Default hash", trie: NewCtrie[int, string], count: 2000},
+		{name: "Colliding hashes", trie: func() *Ctrie[int, string] {
+			return NewCtrieWithHash[int, string](func(key int) uint64 { return uint64(key % 7) })
+		}, count: 200},
+		{name: "Identical low bits", trie: func() *Ctrie[int, string] {
+			return NewCtrieWithHash[int, string](func(key int) uint64 { return uint64(key) << 40 })
+		}, count: 500},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			trie := testCase.trie()
+			assert.Equal(t, trie.Get(1), control.Empty[string]())
+			for i := 0; i < testCase.count; i++ {
+				trie.Put(i, fmt.Sprint(i))
+			}
+			trie.Put(1, "one")
+			assert.Equal(t, trie.Snapshot().Length(), testCase.count)
+			for i := 0; i < testCase.count; i++ {
+				expected := fmt.Sprint(i)
+				if i == 1 {
+					expected = "one"
+				}
+				assert.Equal(t, trie.Get(i), control.Of(expected))
+			}
+			assert.Equal(t, trie.Get(testCase.count), control.Empty[string]())
+
+			for i := 0; i < testCase.count; i += 2 {
+				assert.Equal(t, trie.Remove(i), control.Of(fmt.Sprint(i)))
+			}
+			assert.Equal(t, trie.Remove(0), control.Empty[string]())
+			assert.Equal(t, trie.Snapshot().Length(), testCase.count/2)
+			for i := 0; i < testCase.count; i++ {
+				assert.Equal(t, trie.Get(i).IsEmpty(), i%2 == 0, "unexpected value for key %d", i)
+			}
+			for i := 1; i < testCase.count; i += 2 {
+				trie.Remove(i)
+			}
+			assert.Assert(t, trie.Snapshot().IsEmpty())
+		})
+	}
+}
+
+func TestCtrieSnapshot(t *testing.T) {
+	trie := NewCtrie[string, int]()
+	trie.Put("one", 1)
+	trie.Put("two", 2)
+	snapshot := trie.Snapshot()
+
+	trie.Put("three", 3)
+	trie.Put("one", 10)
+	trie.Remove("two")
+
+	assert.Equal(t, snapshot.Length(), 2)
+	assert.Equal(t, snapshot.Get("one"), control.Of(1))
+	assert.Equal(t, snapshot.Get("two"), control.Of(2))
+	assert.Equal(t, snapshot.Get("three"), control.Empty[int]())
+	assert.Assert(t, snapshot.Contains(NewEntry[string, int]("two", 2, nil)))
+
+	latest := trie.Snapshot()
+	assert.Equal(t, latest.Length(), 2)
+	assert.Equal(t, latest.Get("one"), control.Of(10))
+	assert.Equal(t, latest.Get("two"), control.Empty[int]())
+	assert.Equal(t, FoldSeq[Entry[string, int]](latest, 0, func(sum int, entry Entry[string, int]) int { return sum + entry.GetValue() }), 13)
+
+	assert.Equal(t, CtrieSnapshot[string, int]{}.Length(), 0, "the zero value of a snapshot should be empty")
+	assert.Equal(t, CtrieSnapshot[string, int]{}.Get("one"), control.Empty[int]())
+}
+
+func TestCtriePointerKeys(t *testing.T) {
+	type account struct{ Balance int }
+	first, second := &account{Balance: 1}, &account{Balance: 1}
+	trie := NewCtrie[*account, string]()
+	trie.Put(first, "first")
+	trie.Put(second, "second")
+	first.Balance = 2
+	assert.Equal(t, trie.Get(first), control.Of("first"), "a pointer key should be found after the value it points to changed")
+	assert.Equal(t, trie.Get(second), control.Of("second"), "equal values behind different pointers should be different keys")
+	assert.Equal(t, trie.Remove(first), control.Of("first"))
+	assert.Assert(t, trie.Get(first).IsEmpty(), "a removed pointer key should not be found")
+}
+
+func TestCtrieConcurrentWriters(t *testing.T) {
+	trie := NewCtrie[int, int]()
+	const writers, keys = 8, 500
+	var group sync.WaitGroup
+	for writer := 0; writer < writers; writer++ {
+		group.Add(1)
+		go func(writer int) {
+			defer group.Done()
+			for i := 0; i < keys; i++ {
+				key := writer*keys + i
+				trie.Put(key, key)
+				if i%3 == 0 {
+					assert.Check(t, cmp.Equal(trie.Remove(key), control.Of(key)))
+				}
+			}
+		}(writer)
+	}
+	group.Add(1)
+	go func() {
+		defer group.Done()
+		for i := 0; i < 20; i++ {
+			trie.Snapshot().ForEachWhile(func(entry Entry[int, int]) bool {
+				return assert.Check(t, cmp.Equal(entry.GetKey(), entry.GetValue()))
+			})
+		}
+	}()
+	group.Wait()
+
+	for key := 0; key < writers*keys; key++ {
+		assert.Equal(t, trie.Get(key).IsEmpty(), key%keys%3 == 0, "unexpected value for key %d", key)
+	}
+	assert.Equal(t, trie.Snapshot().Length(), writers*(keys-keys/3-1))
+}
+
+func TestCtrieSnapshotsAreConsistent(t *testing.T) {
+	trie := NewCtrieWithHash[int, int](func(key int) uint64 { return uint64(key * 2654435761) })
+	const total = 3000
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for key := 0; key < total; key++ {
+			trie.Put(key, key)
+		}
+	}()
+
+	var group sync.WaitGroup
+	for reader := 0; reader < 4; reader++ {
+		group.Add(1)
+		go func() {
+			defer group.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				snapshot := trie.Snapshot()
+				length := snapshot.Length()
+				for key := 0; key < total; key++ {
+					if !assert.Check(t, cmp.Equal(snapshot.Get(key).IsEmpty(), key >= length), "a snapshot should contain exactly the first %d keys", length) {
+						return
+					}
+				}
+			}
+		}()
+	}
+	group.Wait()
+	assert.Equal(t, trie.Snapshot().Length(), total)
+}
+
+func BenchmarkCtriePut(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		trie := NewCtrieWithHash[int, int](func(key int) uint64 { return uint64(key * 2654435761) })
+		for key := 0; key < 1000; key++ {
+			trie.Put(key, key)
+		}
+	}
+}
+
+func BenchmarkCtrieGetParallel(b *testing.B) {
+	trie := NewCtrieWithHash[int, int](func(key int) uint64 { return uint64(key * 2654435761) })
+	for key := 0; key < 1000; key++ {
+		trie.Put(key, key)
+	}
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		key := 0
+		for pb.Next() {
+			trie.Get(key % 1000)
+			key++
+		}
+	})
+}
@@ -0,0 +1,101 @@
+package collection
+
+// DList is an immutable difference list, representing a list as a composition of functions prepending elements to a List.
+// Append, Prepend and Concat compose those functions in O(1), and ToList applies them to an empty List in linear time.
+// the composition is kept as a tree rather than as nested closures, so that ToList doesn't depend on the size of the stack.
+// the zero value of a DList is an empty DList.
+type DList[T any] struct {
+	root   *dnode[T]
+	length int
+}
+
+// internal node of a DList, either prepending a single value, prepending a whole List or composing two DLists.
+type dnode[T any] struct {
+	kind  dnodeKind
+	value T
+	list  List[T]
+	left  *dnode[T]
+	right *dnode[T]
+}
+
+// dnodeKind is an internal type identifying the function represented by a dnode.
+type dnodeKind int
+
+const (
+	prependValue dnodeKind = iota
+	prependList
+	compose
+)
+
+// EmptyDList provide an empty DList which could contains elements of T type.
+func EmptyDList[T any]() DList[T] {
+	return DList[T]{}
+}
+
+// DListOf provide a DList which contains the values passed as parameter.
+func DListOf[T any](values ...T) DList[T] {
+	return DListOfList(OfSlice(values))
+}
+
+// DListOfList provide a DList which contains the elements of the List passed as parameter.
+// the List cells are shared and copied by ToList only if elements are appended after them.
+func DListOfList[T any](list List[T]) DList[T] {
+	if list.IsEmpty() {
+		return DList[T]{}
+	}
+	return DList[T]{&dnode[T]{kind: prependList, list: list}, list.Length()}
+}
+
+// IsEmpty checks if the current DList is empty.
+func (d DList[T]) IsEmpty() bool {
+	return d.length == 0
+}
+
+// Length returns the length of the current DList.
+func (d DList[T]) Length() int {
+	return d.length
+}
+
+// Prepend returns a new DList with the value passed as parameter before the elements of the current one.
+func (d DList[T]) Prepend(value T) DList[T] {
+	return DList[T]{&dnode[T]{kind: prependValue, value: value}, 1}.Concat(d)
+}
+
+// Append returns a new DList with the value passed as parameter after the elements of the current one.
+func (d DList[T]) Append(value T) DList[T] {
+	return d.Concat(DList[T]{&dnode[T]{kind: prependValue, value: value}, 1})
+}
+
+// Concat returns a new DList with the elements of the other DList passed as parameter after the elements of the current one.
+func (d DList[T]) Concat(other DList[T]) DList[T] {
+	if d.IsEmpty() {
+		return other
+	}
+	if other.IsEmpty() {
+		return d
+	}
+	return DList[T]{&dnode[T]{kind: compose, left: d.root, right: other.root}, d.length + other.length}
+}
+
+// ToList returns a List containing the elements of the current DList preserving their order.
+func (d DList[T]) ToList() List[T] {
+	result := Empty[T]()
+	if d.root == nil {
+		return result
+	}
+	// nodes are visited from right to left so that each one prepends its elements to the already built suffix
+	pending := []*dnode[T]{d.root}
+	for len(pending) > 0 {
+		current := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		switch current.kind {
+		case prependValue:
+			result = newCons(current.value, result)
+		case prependList:
+			result = Concat(current.list, result)
+		case compose:
+			pending = append(pending, current.left, current.right)
+		}
+	}
+	return result
+}
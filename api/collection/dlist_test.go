@@ -0,0 +1,92 @@
+package collection
+
+import (
+	"fmt"
+	"gotest.tools/v3/assert"
+	"testing"
+)
+
+func TestDListToList(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    DList[int]
+		expected List[int]
+	}{
+		{
+			name:     "Zero value DList",
+			value:    DList[int]{},
+			expected: Empty[int](),
+		},
+		{
+			name:     "Empty DList",
+			value:    EmptyDList[int](),
+			expected: Empty[int](),
+		},
+		{
+			name:     "DList of values",
+			value:    DListOf(1, 2, 3),
+			expected: OfSlice([]int{1, 2, 3}),
+		},
+		{
+			name:     "Appended and prepended values",
+			value:    EmptyDList[int]().Append(2).Append(3).Prepend(1).Append(4),
+			expected: OfSlice([]int{1, 2, 3, 4}),
+		},
+		{
+			name:     "Concatenated DLists",
+			value:    DListOf(1, 2).Concat(EmptyDList[int]()).Concat(DListOfList(OfSlice([]int{3, 4}))).Concat(DListOf(5)),
+			expected: multipleElementsList,
+		},
+		{
+			name:     "Nested concatenations",
+			value:    DListOf(1).Concat(DListOf(2).Concat(DListOf(3))).Concat(DListOf(4).Prepend(0).Concat(DListOf(5))),
+			expected: OfSlice([]int{1, 2, 3, 0, 4, 5}),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := testCase.value.ToList()
+			assert.Assert(t, result.Equals(testCase.expected), fmt.Sprintf("expected %v but value is %v", testCase.expected, result))
+			assert.Equal(t, testCase.value.Length(), testCase.expected.Length())
+			assert.Equal(t, testCase.value.IsEmpty(), testCase.expected.IsEmpty())
+		})
+	}
+}
+
+func TestDListIsPersistent(t *testing.T) {
+	base := DListOf(1, 2)
+	appended := base.Append(3)
+	prepended := base.Prepend(0)
+
+	assert.Assert(t, base.ToList().Equals(OfSlice([]int{1, 2})))
+	assert.Assert(t, appended.ToList().Equals(OfSlice([]int{1, 2, 3})))
+	assert.Assert(t, prepended.ToList().Equals(OfSlice([]int{0, 1, 2})))
+}
+
+func TestDListSharesLastList(t *testing.T) {
+	result := DListOf(0).Concat(DListOfList(multipleElementsList)).ToList()
+	assert.Assert(t, result.tail().first == multipleElementsList.first, "last List should be shared")
+}
+
+func TestDListToListIsStackSafe(t *testing.T) {
+	appended := EmptyDList[int]()
+	prepended := EmptyDList[int]()
+	for i := 0; i < 1000000; i++ {
+		appended = appended.Append(i)
+		prepended = prepended.Prepend(i)
+	}
+	assert.Equal(t, appended.ToList().Length(), 1000000)
+	assert.Equal(t, appended.ToList().HeadOption().OrElse(-1), 0)
+	assert.Equal(t, prepended.ToList().HeadOption().OrElse(-1), 999999)
+}
+
+func BenchmarkDListAppend(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		list := EmptyDList[int]()
+		for j := 0; j < 1000; j++ {
+			list = list.Append(j)
+		}
+		listSink = list.ToList()
+	}
+}
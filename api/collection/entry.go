@@ -2,6 +2,7 @@ package collection
 
 import (
 	"github.com/mitchellh/hashstructure/v2"
+	"glours/go2funk/api"
 	"reflect"
 )
 
@@ -43,4 +44,14 @@ func (m MapEntry[K, V]) Equals(entry  Entry[K, V]) bool {
 
 func (m MapEntry[K, V]) HashCode() (uint64, error) {
 	return hashstructure.Hash(m, hashstructure.FormatV2, nil)
-}
\ No newline at end of file
+}
+
+// EntryOfPair returns an Entry with the "left" value of the Pair as key and the "right" value as value.
+func EntryOfPair[K comparable, V any](pair api.Pair[K, V]) Entry[K, V] {
+	return NewEntry[K, V](pair.GetLeft(), pair.GetRight(), nil)
+}
+
+// PairOfEntry returns a Pair with the key of the Entry as "left" value and its value as "right" value.
+func PairOfEntry[K comparable, V any](entry Entry[K, V]) api.Pair[K, V] {
+	return api.NewPair(entry.GetKey(), entry.GetValue())
+}
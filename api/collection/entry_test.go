@@ -2,6 +2,7 @@ package collection
 
 import (
 	"fmt"
+	"glours/go2funk/api"
 	"gotest.tools/v3/assert"
 	"testing"
 )
@@ -28,3 +29,13 @@ func TestHashCode(t *testing.T) {
 	assert.NilError(t, err)
 	assert.Equal(t, hash, hashCheck, fmt.Sprintf("Hash value should be %d but is %d", hashCheck, hash))
 }
+
+func TestEntryOfPair(t *testing.T) {
+	result := EntryOfPair(api.NewPair(10, "ten"))
+	assert.Assert(t, result.Equals(entry), fmt.Sprintf("entry should be (10, ten) but is (%d, %s)", result.GetKey(), result.GetValue()))
+}
+
+func TestPairOfEntry(t *testing.T) {
+	result := PairOfEntry(entry)
+	assert.Equal(t, result, api.NewPair(10, "ten"), fmt.Sprintf("pair should be (10, ten) but is %s", result))
+}
@@ -0,0 +1,94 @@
+package collection
+
+import (
+	"fmt"
+
+	"glours/go2funk/api"
+	"glours/go2funk/api/control"
+)
+
+// Range returns a List of the integers from start (inclusive) to end (exclusive) separated by step.
+// a negative step produces a decreasing List, and an empty List is returned if end can't be reached from start.
+// this function returns error if the step is equal to 0.
+func Range[T api.Integer](start T, end T, step T) (List[T], error) {
+	if step == 0 {
+		return Empty[T](), fmt.Errorf("invalid step %v on Range", step)
+	}
+	// distances are computed on uint64 so ranges spanning the whole domain of T don't overflow
+	var length uint64
+	switch {
+	case step > 0 && start < end:
+		length = (uint64(end)-uint64(start)-1)/uint64(step) + 1
+	case step < 0 && start > end:
+		length = (uint64(start)-uint64(end)-1)/(0-uint64(step)) + 1
+	default:
+		return Empty[T](), nil
+	}
+	result := Empty[T]()
+	for i := length; i > 0; i-- {
+		result = newCons(start+T(i-1)*step, result)
+	}
+	return result, nil
+}
+
+// Tabulate returns a List of n elements where each element is the result of the function applied to its index.
+func Tabulate[T any](n int, f func(int) T) List[T] {
+	result := Empty[T]()
+	for i := n - 1; i >= 0; i-- {
+		result = newCons(f(i), result)
+	}
+	return result
+}
+
+// Fill returns a List of n elements all equal to the value passed as parameter.
+func Fill[T any](n int, value T) List[T] {
+	result := Empty[T]()
+	for i := 0; i < n; i++ {
+		result = newCons(value, result)
+	}
+	return result
+}
+
+// Iterate returns a List of n elements starting with seed, each following element being the result of f applied to the previous one.
+func Iterate[T any](seed T, f func(T) T, n int) List[T] {
+	if n <= 0 {
+		return Empty[T]()
+	}
+	elements := make([]T, n)
+	elements[0] = seed
+	for i := 1; i < n; i++ {
+		elements[i] = f(elements[i-1])
+	}
+	return fromSlice(elements)
+}
+
+// Unfold returns a List built from a seed state, the function producing the next element and the next state of the generation.
+// the generation stops when the function returns an empty Option.
+func Unfold[T, S any](seed S, f func(S) control.Option[api.Pair[T, S]]) List[T] {
+	var elements []T
+	state := seed
+	for next := f(state); !next.IsEmpty(); next = f(state) {
+		pair := next.OrElse(api.Pair[T, S]{})
+		elements = append(elements, pair.GetLeft())
+		state = pair.GetRight()
+	}
+	return fromSlice(elements)
+}
+
+// Concat returns a List containing the elements of all the lists passed as parameter preserving their order.
+// the last non-empty list is shared by the result and isn't copied.
+func Concat[T any](lists ...List[T]) List[T] {
+	for len(lists) > 0 && lists[len(lists)-1].IsEmpty() {
+		lists = lists[:len(lists)-1]
+	}
+	if len(lists) == 0 {
+		return Empty[T]()
+	}
+	var result appender[T]
+	for _, list := range lists[:len(lists)-1] {
+		for current := list.first; current != nil; current = current.next {
+			result.add(current.value)
+		}
+	}
+	return result.result(lists[len(lists)-1])
+}
@@ -0,0 +1,132 @@
+package collection
+
+import (
+	"fmt"
+	"glours/go2funk/api"
+	"glours/go2funk/api/control"
+	"gotest.tools/v3/assert"
+	"testing"
+)
+
+func TestRange(t *testing.T) {
+	testCases := []struct {
+		name     string
+		start    int
+		end      int
+		step     int
+		expected List[int]
+	}{
+		{
+			name:     "Empty Range",
+			start:    3,
+			end:      3,
+			step:     1,
+			expected: Empty[int](),
+		},
+		{
+			name:     "Unreachable end",
+			start:    5,
+			end:      1,
+			step:     1,
+			expected: Empty[int](),
+		},
+		{
+			name:     "Increasing Range",
+			start:    1,
+			end:      6,
+			step:     1,
+			expected: multipleElementsList,
+		},
+		{
+			name:     "Increasing Range with step",
+			start:    0,
+			end:      10,
+			step:     3,
+			expected: OfSlice([]int{0, 3, 6, 9}),
+		},
+		{
+			name:     "Decreasing Range",
+			start:    5,
+			end:      -5,
+			step:     -4,
+			expected: OfSlice([]int{5, 1, -3}),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := Range(testCase.start, testCase.end, testCase.step)
+			assert.NilError(t, err)
+			assert.Assert(t, result.Equals(testCase.expected), fmt.Sprintf("expected %+v but value is %+v", testCase.expected, result))
+		})
+	}
+}
+
+func TestRangeEdgeCases(t *testing.T) {
+	_, err := Range(0, 10, 0)
+	assert.Error(t, err, "invalid step 0 on Range")
+
+	full, err := Range[int8](-128, 127, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, full.Length(), 255)
+	assert.Equal(t, full.LastOption(), control.Of[int8](126))
+
+	unsigned, err := Range[uint8](250, 0, 1)
+	assert.NilError(t, err)
+	assert.Assert(t, unsigned.IsEmpty())
+}
+
+func TestTabulateAndFill(t *testing.T) {
+	square := Tabulate(4, func(i int) int { return i * i })
+	assert.Assert(t, square.Equals(OfSlice([]int{0, 1, 4, 9})), fmt.Sprintf("unexpected value %+v", square))
+	assert.Equal(t, Tabulate(-1, func(i int) int { return i }), emptyList)
+
+	filled := Fill(3, "a")
+	assert.Assert(t, filled.Equals(OfSlice([]string{"a", "a", "a"})), fmt.Sprintf("unexpected value %+v", filled))
+	assert.Equal(t, Fill(0, 10), emptyList)
+}
+
+func TestIterate(t *testing.T) {
+	powers := Iterate(1, func(value int) int { return value * 2 }, 5)
+	assert.Assert(t, powers.Equals(OfSlice([]int{1, 2, 4, 8, 16})), fmt.Sprintf("unexpected value %+v", powers))
+	assert.Equal(t, Iterate(1, func(value int) int { return value }, 0), emptyList)
+}
+
+func TestUnfold(t *testing.T) {
+	countdown := Unfold(3, func(state int) control.Option[api.Pair[string, int]] {
+		if state == 0 {
+			return control.Empty[api.Pair[string, int]]()
+		}
+		return control.Of(api.NewPair(fmt.Sprintf("#%d", state), state-1))
+	})
+	assert.Assert(t, countdown.Equals(OfSlice([]string{"#3", "#2", "#1"})), fmt.Sprintf("unexpected value %+v", countdown))
+}
+
+func TestConcat(t *testing.T) {
+	testCases := []struct {
+		name     string
+		lists    []List[int]
+		expected List[int]
+	}{
+		{
+			name:     "No List",
+			lists:    nil,
+			expected: Empty[int](),
+		},
+		{
+			name:     "Empty Lists",
+			lists:    []List[int]{emptyList, emptyList},
+			expected: Empty[int](),
+		},
+		{
+			name:     "Multiple Lists",
+			lists:    []List[int]{singleElementList, emptyList, multipleElementsList, Of(6)},
+			expected: OfSlice([]int{10, 1, 2, 3, 4, 5, 6}),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := Concat(testCase.lists...)
+			assert.Assert(t, result.Equals(testCase.expected), fmt.Sprintf("expected %+v but value is %+v", testCase.expected, result))
+		})
+	}
+}
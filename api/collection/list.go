@@ -4,35 +4,52 @@ package collection
 import (
 	"fmt"
 	"reflect"
+
+	"glours/go2funk/api/control"
 )
 
-// List is a immutable interface of List collection.
-type List[T any] interface {
-	head() T
-	tail() List[T]
-	IsEmpty() bool
-	Append(value T) List[T]
-	AppendAll(values []T) List[T]
-	Length() int
-	Filter(func(T) bool) List[T]
-	Remove(value T) List[T]
-	RemovePredicate(func(T) bool) List[T]
-	Insert(int, T) (List[T], error)
-	Reverse() List[T]
+// List is an immutable singly linked list.
+// the zero value of a List is an empty List, and lists share their cells so copying a List is cheap.
+type List[T any] struct {
+	first  *node[T]
+	length int
+}
+
+// internal cell of a non-empty List, consisting of a value of type T and a pointer to the next cell.
+// cells are never modified once they are reachable from a List.
+type node[T any] struct {
+	value T
+	next  *node[T]
 }
 
 // MapList maps the elements of the List[T] to elements of a new type U preserving their order, if any.
 func MapList[T any, U any](list List[T], mapper func(T) U) List[U] {
-	result := Empty[U]()
-	if list.IsEmpty() {
-		return result
+	var result appender[U]
+	for current := list.first; current != nil; current = current.next {
+		result.add(mapper(current.value))
+	}
+	return result.result(Empty[U]())
+}
+
+// FlatMapList maps each element of the List[T] to a List[U] and concatenates the results preserving their order.
+func FlatMapList[T any, U any](list List[T], mapper func(T) List[U]) List[U] {
+	var result appender[U]
+	for current := list.first; current != nil; current = current.next {
+		for inner := mapper(current.value).first; inner != nil; inner = inner.next {
+			result.add(inner.value)
+		}
 	}
-	return newCons[U](mapper(list.head()), MapList[T, U](list.tail(), mapper))
+	return result.result(Empty[U]())
+}
+
+// Flatten concatenates the lists contained by the List[List[T]] into a single List[T] preserving their order.
+func Flatten[T any](lists List[List[T]]) List[T] {
+	return FlatMapList(lists, identity[List[T]])
 }
 
 // Empty provide an empty List which could contains elements of T type.
 func Empty[T any]() List[T] {
-	return empty[T]{}
+	return List[T]{}
 }
 
 // Of provide a single element List of type T.
@@ -42,192 +59,337 @@ func Of[T any](value T) List[T] {
 
 // OfSlice provide a List which contains the elements of type T provided by the array passed as parameter.
 func OfSlice[T any](elements []T) List[T] {
-	result := Empty[T]()
-	return result.AppendAll(elements)
+	return fromSlice(elements)
 }
 
-// internal implementation of an non-empty List, consisting of a head of type T and a tail of type List[T].
-type cons[T any] struct {
-	consHead T
-	consTail List[T]
-	length   int
+// fromSlice is an internal function building a List from a slice in linear time by prepending its elements from the end.
+func fromSlice[T any](elements []T) List[T] {
+	result := Empty[T]()
+	for i := len(elements) - 1; i >= 0; i-- {
+		result = newCons(elements[i], result)
+	}
+	return result
 }
 
-// newCons is an internal function used to create a new list
+// newCons is an internal function used to create a new list with value as head and the tail passed as parameter.
 func newCons[T any](value T, tail List[T]) List[T] {
-	return cons[T]{
-		consHead: value,
-		consTail: tail,
-		length:   1 + tail.Length(),
+	return List[T]{
+		first:  &node[T]{value: value, next: tail.first},
+		length: 1 + tail.length,
+	}
+}
+
+// appender is an internal helper building a new List from front to back by linking fresh cells.
+type appender[T any] struct {
+	first  *node[T]
+	last   *node[T]
+	length int
+}
+
+// add links a new cell containing the value at the end of the list being built.
+func (a *appender[T]) add(value T) {
+	cell := &node[T]{value: value}
+	if a.last == nil {
+		a.first = cell
+	} else {
+		a.last.next = cell
+	}
+	a.last = cell
+	a.length++
+}
+
+// result returns the List built so far followed by the cells of the suffix, which are shared and not copied.
+// the appender should not be used anymore after calling result.
+func (a *appender[T]) result(suffix List[T]) List[T] {
+	if a.last == nil {
+		return suffix
 	}
+	a.last.next = suffix.first
+	return List[T]{first: a.first, length: a.length + suffix.length}
 }
 
-// head is an internal function used to get the head value of the current List
-func (c cons[T]) head() T {
-	return c.consHead
+// head is an internal function used to get the head value of the current List.
+// the zero value of T is returned for an empty List.
+func (l List[T]) head() T {
+	if l.first == nil {
+		return *new(T)
+	}
+	return l.first.value
 }
 
-// tail is an internal function used to get the tail of the current List
-func (c cons[T]) tail() List[T] {
-	return c.consTail
+// tail is an internal function used to get the tail of the current List.
+// an empty List is returned for an empty List.
+func (l List[T]) tail() List[T] {
+	if l.first == nil {
+		return l
+	}
+	return List[T]{first: l.first.next, length: l.length - 1}
+}
+
+// drop is an internal function returning the cells of the current List after the n first ones.
+func (l List[T]) drop(n int) List[T] {
+	current := l
+	for i := 0; i < n && current.first != nil; i++ {
+		current = current.tail()
+	}
+	return current
 }
 
 // IsEmpty checks if the current List is empty.
-// for the cons implementation of List interface, it always return false.
-func (c cons[T]) IsEmpty() bool {
-	return false
+func (l List[T]) IsEmpty() bool {
+	return l.first == nil
 }
 
 // Append returns a new List with the T value passed as parameter at the end of the new list created.
-func (c cons[T]) Append(value T) List[T] {
-	return newCons(c.consHead, c.consTail.Append(value))
+func (l List[T]) Append(value T) List[T] {
+	return l.AppendAll([]T{value})
 }
 
 // AppendAll returns a new List with the T values passed as an array at the end of the new list created.
-func (c cons[T]) AppendAll(values []T) List[T] {
-	result := newCons[T](c.consHead, c.consTail)
-	for _, value := range values {
-		result = result.Append(value)
+func (l List[T]) AppendAll(values []T) List[T] {
+	if len(values) == 0 {
+		return l
 	}
-	return result
+	var result appender[T]
+	for current := l.first; current != nil; current = current.next {
+		result.add(current.value)
+	}
+	return result.result(fromSlice(values))
 }
 
 // Length returns the length of the current list.
-func (c cons[T]) Length() int {
-	return c.length
+func (l List[T]) Length() int {
+	return l.length
 }
 
 // Filter returns a new list containing only the elements which are validating the predicate passed as parameter.
-func (c cons[T]) Filter(predicate func(T) bool) List[T] {
-	if predicate(c.consHead) {
-		return newCons[T](c.consHead, c.consTail.Filter(predicate))
-	}
-	if c.tail().IsEmpty() {
-		return c.consTail
+func (l List[T]) Filter(predicate func(T) bool) List[T] {
+	var result appender[T]
+	for current := l.first; current != nil; current = current.next {
+		if predicate(current.value) {
+			result.add(current.value)
+		}
 	}
-	return newCons(c.consTail.head(), c.consTail.tail()).Filter(predicate)
+	return result.result(Empty[T]())
 }
 
 // Remove returns a new list without all the elements matching the value passed as parameter.
-func (c cons[T]) Remove(value T) List[T] {
-	if !reflect.DeepEqual(c.consHead, value) {
-		return newCons(c.consHead, c.consTail.Remove(value))
-	}
-	if c.consTail.IsEmpty() {
-		return c.consTail
-	}
-	return newCons(c.consTail.head(), c.consTail.tail()).Remove(value)
+func (l List[T]) Remove(value T) List[T] {
+	return l.RemovePredicate(func(element T) bool {
+		return reflect.DeepEqual(element, value)
+	})
 }
 
 // RemovePredicate returns a new list without all the elements matching the predicate passed as parameter.
-func (c cons[T]) RemovePredicate(predicate func(T) bool) List[T] {
-	if !predicate(c.consHead) {
-		return newCons(c.consHead, c.consTail.RemovePredicate(predicate))
-	}
-	if c.consTail.IsEmpty() {
-		return c.consTail
-	}
-	return newCons(c.consTail.head(), c.consTail.tail()).RemovePredicate(predicate)
+func (l List[T]) RemovePredicate(predicate func(T) bool) List[T] {
+	return l.Filter(func(value T) bool {
+		return !predicate(value)
+	})
 }
 
 // Insert returns a new list with the value passed as parameter at the position matching the index.
 // this function returns error if the index is less than 0 or greater than list length.
-func (c cons[T]) Insert(index int, value T) (List[T], error) {
-	if index < 0 {
-		return Empty[T](), fmt.Errorf("index out of range %d on List", index)
+func (l List[T]) Insert(index int, value T) (List[T], error) {
+	if index == l.length && index >= 0 {
+		return l.Append(value), nil
 	}
-	if index == 0 {
-		return newCons[T](value, c), nil
+	if err := l.checkIndex(index); err != nil {
+		return Empty[T](), err
 	}
-	if c.IsEmpty() {
-		return Empty[T](), fmt.Errorf("index out of range %d on List", index)
+	prefix := l.copyPrefix(index)
+	return prefix.result(newCons(value, l.drop(index))), nil
+}
+
+// Reverse returns a reversed version of the current list.
+func (l List[T]) Reverse() List[T] {
+	result := Empty[T]()
+	for current := l.first; current != nil; current = current.next {
+		result = newCons(current.value, result)
 	}
-	tail, err := c.consTail.Insert(index-1, value)
-	if err != nil {
-		return Empty[T](), err
+	return result
+}
+
+// Get returns an Option containing the element at the position matching the index.
+// an empty Option is returned if the index is less than 0 or greater than or equal to the list length.
+func (l List[T]) Get(index int) control.Option[T] {
+	if index < 0 || index >= l.length {
+		return control.Empty[T]()
 	}
-	return newCons[T](c.consHead, tail), nil
+	return control.Of(l.drop(index).first.value)
+}
 
+// HeadOption returns an Option containing the first element of the current list.
+// an empty Option is returned for an empty list.
+func (l List[T]) HeadOption() control.Option[T] {
+	return l.Get(0)
 }
 
-// Reverse returns a reversed version of the current list.
-func (c cons[T]) Reverse() List[T] {
-	if c.IsEmpty() || c.length == 1 {
-		return c
+// LastOption returns an Option containing the last element of the current list.
+// an empty Option is returned for an empty list.
+func (l List[T]) LastOption() control.Option[T] {
+	return l.Get(l.length - 1)
+}
+
+// Find returns an Option containing the first element which is validating the predicate passed as parameter.
+func (l List[T]) Find(predicate func(T) bool) control.Option[T] {
+	for current := l.first; current != nil; current = current.next {
+		if predicate(current.value) {
+			return control.Of(current.value)
+		}
 	}
-	value := c.consHead
-	list := c.consTail.Reverse()
-	return list.Append(value)
+	return control.Empty[T]()
 }
 
-// internal implementation of an empty list which could contain element of T type.
-type empty[T any] struct{}
+// IndexOf returns the index of the first element matching the value passed as parameter or -1 if there is none.
+func (l List[T]) IndexOf(value T) int {
+	return l.IndexWhere(func(element T) bool {
+		return reflect.DeepEqual(element, value)
+	})
+}
 
-// head is an internal function used to get the head value of the current List
-func (n empty[T]) head() T {
-	return *new(T)
+// IndexWhere returns the index of the first element validating the predicate passed as parameter or -1 if there is none.
+func (l List[T]) IndexWhere(predicate func(T) bool) int {
+	index := 0
+	for current := l.first; current != nil; current = current.next {
+		if predicate(current.value) {
+			return index
+		}
+		index++
+	}
+	return -1
 }
 
-// tail is an internal function used to get the tail of the current List
-func (n empty[T]) tail() List[T] {
-	return Empty[T]()
+// LastIndexWhere returns the index of the last element validating the predicate passed as parameter or -1 if there is none.
+func (l List[T]) LastIndexWhere(predicate func(T) bool) int {
+	last := -1
+	index := 0
+	for current := l.first; current != nil; current = current.next {
+		if predicate(current.value) {
+			last = index
+		}
+		index++
+	}
+	return last
 }
 
-// IsEmpty checks if the current List is empty.
-// for the empty implementation of List interface, it always return true.
-func (n empty[T]) IsEmpty() bool {
-	return true
+// ForEachWhile calls the function passed as parameter on each element of the current list, in order, until it returns false.
+func (l List[T]) ForEachWhile(f func(T) bool) {
+	for current := l.first; current != nil && f(current.value); current = current.next {
+	}
 }
 
-// Length returns the length of the current list.
-func (n empty[T]) Length() int {
-	return 0
+// Exists checks if at least one element of the current list is validating the predicate passed as parameter.
+func (l List[T]) Exists(predicate func(T) bool) bool {
+	return l.IndexWhere(predicate) >= 0
 }
 
-// Append returns a new List with the T value passed as parameter at the end of the new list created.
-// for the empty implementation of list interface, the function return a new single element List with value as head.
-func (n empty[T]) Append(value T) List[T] {
-	return newCons[T](value, Empty[T]())
+// ForAll checks if all the elements of the current list are validating the predicate passed as parameter.
+// it always returns true for an empty list.
+func (l List[T]) ForAll(predicate func(T) bool) bool {
+	return !l.Exists(func(value T) bool {
+		return !predicate(value)
+	})
 }
 
-// AppendAll returns a new List with the T values passed as an array at the end of the new list created.
-func (n empty[T]) AppendAll(elements []T) List[T] {
-	result := Empty[T]()
-	for _, element := range elements {
-		result = result.Append(element)
+// Count returns the number of elements validating the predicate passed as parameter.
+func (l List[T]) Count(predicate func(T) bool) int {
+	count := 0
+	for current := l.first; current != nil; current = current.next {
+		if predicate(current.value) {
+			count++
+		}
 	}
-	return result
+	return count
 }
 
-// Filter returns a new list containing only the elements which are validating the predicate passed as parameter.
-// for the empty implementation, the current empty list is returned.
-func (n empty[T]) Filter(predicate func(T) bool) List[T] {
-	return n
+// Contains checks if the current list contains an element matching the value passed as parameter.
+func (l List[T]) Contains(value T) bool {
+	return l.IndexOf(value) >= 0
 }
 
-// Remove returns a new list without all the elements matching the value passed as parameter.
-// for the empty implementation of the List interface, the current empty list is returned.
-func (n empty[T]) Remove(value T) List[T] {
-	return n
+// Update returns a new list with the value passed as parameter replacing the element at the position matching the index.
+// this function returns error if the index is less than 0 or greater than or equal to list length.
+func (l List[T]) Update(index int, value T) (List[T], error) {
+	if err := l.checkIndex(index); err != nil {
+		return Empty[T](), err
+	}
+	prefix := l.copyPrefix(index)
+	return prefix.result(newCons(value, l.drop(index+1))), nil
 }
 
-// RemovePredicate returns a new list without all the elements matching the predicate passed as parameter.
-// for the empty implementation of the List interface, the current empty list is returned.
-func (n empty[T]) RemovePredicate(predicate func(T) bool) List[T] {
-	return n
+// RemoveAt returns a new list without the element at the position matching the index.
+// this function returns error if the index is less than 0 or greater than or equal to list length.
+func (l List[T]) RemoveAt(index int) (List[T], error) {
+	if err := l.checkIndex(index); err != nil {
+		return Empty[T](), err
+	}
+	prefix := l.copyPrefix(index)
+	return prefix.result(l.drop(index + 1)), nil
 }
 
-// Insert returns a new list with the value passed as parameter at the position matching the index.
-// this function returns error if the index is less than 0 or greater than list length.
-func (n empty[T]) Insert(index int, value T) (List[T], error) {
-	if index != 0 {
-		return n, fmt.Errorf("index out of range %d on empty List", index)
+// Intersperse returns a new list with the separator passed as parameter inserted between each element of the current list.
+func (l List[T]) Intersperse(separator T) List[T] {
+	var result appender[T]
+	for current := l.first; current != nil; current = current.next {
+		if current != l.first {
+			result.add(separator)
+		}
+		result.add(current.value)
 	}
-	return newCons[T](value, n), nil
+	return result.result(Empty[T]())
 }
 
-// Reverse returns a reversed version of the current list.
-// for the empty implementation, the current empty list is returned.
-func (n empty[T]) Reverse() List[T] {
-	return n
+// Interleave returns a new list alternating the elements of the current list and the other list passed as parameter.
+// the remaining elements of the longest list are appended at the end of the new list.
+func (l List[T]) Interleave(other List[T]) List[T] {
+	var result appender[T]
+	left, right := l, other
+	for !left.IsEmpty() && !right.IsEmpty() {
+		result.add(left.first.value)
+		result.add(right.first.value)
+		left, right = left.tail(), right.tail()
+	}
+	if left.IsEmpty() {
+		return result.result(right)
+	}
+	return result.result(left)
+}
+
+// Equals checks if the other list passed as parameter contains the same elements in the same order as the current one.
+// elements are compared with reflect.DeepEqual.
+func (l List[T]) Equals(other List[T]) bool {
+	if l.length != other.length {
+		return false
+	}
+	for left, right := l.first, other.first; left != right; left, right = left.next, right.next {
+		if !reflect.DeepEqual(left.value, right.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkIndex is an internal function returning an error if the index doesn't match an element of the current list.
+// the error messages mirror the historical recursive implementation, which reported the remaining index once the end of the list was reached.
+func (l List[T]) checkIndex(index int) error {
+	switch {
+	case l.IsEmpty():
+		return fmt.Errorf("index out of range %d on empty List", index)
+	case index < 0:
+		return fmt.Errorf("index out of range %d on List", index)
+	case index >= l.length:
+		return fmt.Errorf("index out of range %d on empty List", index-l.length)
+	}
+	return nil
+}
+
+// copyPrefix is an internal function returning an appender containing a copy of the n first elements of the current list.
+func (l List[T]) copyPrefix(n int) *appender[T] {
+	var prefix appender[T]
+	current := l.first
+	for i := 0; i < n; i++ {
+		prefix.add(current.value)
+		current = current.next
+	}
+	return &prefix
 }
@@ -2,18 +2,13 @@ package collection
 
 import (
 	"fmt"
+	"glours/go2funk/api/control"
 	"gotest.tools/v3/assert"
 	"strconv"
 	"testing"
 )
 
 var (
-	_ List[int] = empty[int]{}
-	_ List[int] = cons[int]{
-		consHead: 10,
-		consTail: Empty[int](),
-		length:   1,
-	}
 	emptyList            = Empty[int]()
 	singleElementList    = Of[int](10)
 	multipleElementsList = OfSlice([]int{1, 2, 3, 4, 5})
@@ -23,6 +18,47 @@ var (
 	}
 )
 
+func TestZeroValueList(t *testing.T) {
+	var zero List[int]
+	assert.Assert(t, zero.IsEmpty(), "zero value should be an empty List")
+	assert.Equal(t, zero.Length(), 0)
+	assert.Assert(t, zero.Equals(emptyList))
+	assert.Assert(t, zero.Append(1).Equals(Of(1)))
+	assert.Equal(t, zero.String(), "List()")
+}
+
+func TestEqualsList(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    List[int]
+		other    List[int]
+		expected bool
+	}{
+		{name: "Empty Lists", value: emptyList, other: Empty[int](), expected: true},
+		{name: "Same List", value: multipleElementsList, other: multipleElementsList, expected: true},
+		{name: "Equal Lists", value: multipleElementsList, other: OfSlice([]int{1, 2, 3, 4, 5}), expected: true},
+		{name: "Different lengths", value: multipleElementsList, other: singleElementList, expected: false},
+		{name: "Different elements", value: OfSlice([]int{1, 2}), other: OfSlice([]int{1, 3}), expected: false},
+		{name: "Shared tail", value: multipleElementsList.tail(), other: newCons(7, multipleElementsList.tail()).tail(), expected: true},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := testCase.value.Equals(testCase.other)
+			assert.Equal(t, result, testCase.expected, fmt.Sprintf("expected %t but value is %t", testCase.expected, result))
+		})
+	}
+}
+
+func TestStructuralSharing(t *testing.T) {
+	updated, err := multipleElementsList.Update(1, 7)
+	assert.NilError(t, err)
+	assert.Assert(t, updated.drop(2).first == multipleElementsList.drop(2).first, "cells after the updated index should be shared")
+	assert.Assert(t, multipleElementsList.Equals(OfSlice([]int{1, 2, 3, 4, 5})), "original List should not be modified")
+
+	prepended := newCons(0, multipleElementsList)
+	assert.Assert(t, prepended.tail().first == multipleElementsList.first, "prepending should share the whole List")
+}
+
 func TestHead(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -76,7 +112,7 @@ func TestTail(t *testing.T) {
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			assert.Equal(t, testCase.value.tail(), testCase.expected, fmt.Sprintf("expected %d but value is %d", testCase.expected, testCase.value.tail()))
+			assert.Assert(t, testCase.value.tail().Equals(testCase.expected), fmt.Sprintf("expected %v but value is %v", testCase.expected, testCase.value.tail()))
 		})
 	}
 }
@@ -227,7 +263,7 @@ func TestMapList(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			result := MapList[int, string](testCase.value, mapper)
-			assert.Equal(t, result, testCase.expected, fmt.Sprintf("expected %+v but value is %+v", testCase.expected, result))
+			assert.Assert(t, result.Equals(testCase.expected), fmt.Sprintf("expected %+v but value is %+v", testCase.expected, result))
 		})
 	}
 }
@@ -257,7 +293,7 @@ func TestFilterList(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			result := testCase.value.Filter(evenPredicate)
-			assert.Equal(t, result, testCase.expected, fmt.Sprintf("expected %+v but value is %+v", testCase.expected, result))
+			assert.Assert(t, result.Equals(testCase.expected), fmt.Sprintf("expected %+v but value is %+v", testCase.expected, result))
 		})
 	}
 }
@@ -297,7 +333,7 @@ func TestRemoveFromList(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			result := testCase.original.Remove(testCase.valueToRemove)
-			assert.Equal(t, result, testCase.expected, fmt.Sprintf("expected %+v but value is %+v", testCase.expected, result))
+			assert.Assert(t, result.Equals(testCase.expected), fmt.Sprintf("expected %+v but value is %+v", testCase.expected, result))
 		})
 	}
 }
@@ -337,7 +373,7 @@ func TestRemoveFromListWithPredicate(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			result := testCase.original.RemovePredicate(testCase.predicate)
-			assert.Equal(t, result, testCase.expected, fmt.Sprintf("expected %+v but value is %+v", testCase.expected, result))
+			assert.Assert(t, result.Equals(testCase.expected), fmt.Sprintf("expected %+v but value is %+v", testCase.expected, result))
 		})
 	}
 }
@@ -444,7 +480,7 @@ func TestInsertInList(t *testing.T) {
 					t.Errorf("index of range error was expected")
 				}
 			} else {
-				if result != testCase.expected {
+				if !result.Equals(testCase.expected) {
 					t.Errorf("expected %+v but value is %+v", testCase.expected, result)
 				}
 			}
@@ -477,7 +513,469 @@ func TestReverseList(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
 			result := testCase.original.Reverse()
+			assert.Assert(t, result.Equals(testCase.expected), fmt.Sprintf("expected %+v but value is %+v", testCase.expected, result))
+		})
+	}
+}
+
+func TestGetFromList(t *testing.T) {
+	testCases := []struct {
+		name     string
+		original List[int]
+		index    int
+		expected control.Option[int]
+	}{
+		{
+			name:     "Empty List",
+			original: emptyList,
+			index:    0,
+			expected: control.Empty[int](),
+		},
+		{
+			name:     "Single Element List index 0",
+			original: singleElementList,
+			index:    0,
+			expected: control.Of(10),
+		},
+		{
+			name:     "Multiple Elements List index 3",
+			original: multipleElementsList,
+			index:    3,
+			expected: control.Of(4),
+		},
+		{
+			name:     "Multiple Elements List negative index",
+			original: multipleElementsList,
+			index:    -1,
+			expected: control.Empty[int](),
+		},
+		{
+			name:     "Multiple Elements List index out of bounds",
+			original: multipleElementsList,
+			index:    5,
+			expected: control.Empty[int](),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := testCase.original.Get(testCase.index)
 			assert.Equal(t, result, testCase.expected, fmt.Sprintf("expected %+v but value is %+v", testCase.expected, result))
 		})
 	}
 }
+
+func TestHeadAndLastOption(t *testing.T) {
+	testCases := []struct {
+		name         string
+		original     List[int]
+		expectedHead control.Option[int]
+		expectedLast control.Option[int]
+	}{
+		{
+			name:         "Empty List",
+			original:     emptyList,
+			expectedHead: control.Empty[int](),
+			expectedLast: control.Empty[int](),
+		},
+		{
+			name:         "Single Element List",
+			original:     singleElementList,
+			expectedHead: control.Of(10),
+			expectedLast: control.Of(10),
+		},
+		{
+			name:         "Multiple Elements List",
+			original:     multipleElementsList,
+			expectedHead: control.Of(1),
+			expectedLast: control.Of(5),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			head := testCase.original.HeadOption()
+			assert.Equal(t, head, testCase.expectedHead, fmt.Sprintf("expected %+v but value is %+v", testCase.expectedHead, head))
+			last := testCase.original.LastOption()
+			assert.Equal(t, last, testCase.expectedLast, fmt.Sprintf("expected %+v but value is %+v", testCase.expectedLast, last))
+		})
+	}
+}
+
+func TestFindInList(t *testing.T) {
+	testCases := []struct {
+		name      string
+		original  List[int]
+		predicate func(int) bool
+		expected  control.Option[int]
+	}{
+		{
+			name:      "Empty List",
+			original:  emptyList,
+			predicate: evenPredicate,
+			expected:  control.Empty[int](),
+		},
+		{
+			name:      "Single Element List without match",
+			original:  singleElementList,
+			predicate: func(value int) bool { return value == 5 },
+			expected:  control.Empty[int](),
+		},
+		{
+			name:      "Multiple Elements List",
+			original:  multipleElementsList,
+			predicate: evenPredicate,
+			expected:  control.Of(2),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := testCase.original.Find(testCase.predicate)
+			assert.Equal(t, result, testCase.expected, fmt.Sprintf("expected %+v but value is %+v", testCase.expected, result))
+		})
+	}
+}
+
+func TestIndexOperations(t *testing.T) {
+	list := OfSlice([]int{1, 2, 3, 2, 1})
+	testCases := []struct {
+		name     string
+		result   int
+		expected int
+	}{
+		{name: "IndexOf on empty List", result: emptyList.IndexOf(1), expected: -1},
+		{name: "IndexOf existing value", result: list.IndexOf(2), expected: 1},
+		{name: "IndexOf missing value", result: list.IndexOf(7), expected: -1},
+		{name: "IndexWhere on empty List", result: emptyList.IndexWhere(evenPredicate), expected: -1},
+		{name: "IndexWhere with match", result: list.IndexWhere(evenPredicate), expected: 1},
+		{name: "LastIndexWhere on empty List", result: emptyList.LastIndexWhere(evenPredicate), expected: -1},
+		{name: "LastIndexWhere with match", result: list.LastIndexWhere(evenPredicate), expected: 3},
+		{name: "LastIndexWhere without match", result: list.LastIndexWhere(func(value int) bool { return value > 5 }), expected: -1},
+		{name: "Count on empty List", result: emptyList.Count(evenPredicate), expected: 0},
+		{name: "Count with matches", result: list.Count(evenPredicate), expected: 2},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.result, testCase.expected, fmt.Sprintf("expected %d but value is %d", testCase.expected, testCase.result))
+		})
+	}
+}
+
+func TestPredicateChecks(t *testing.T) {
+	testCases := []struct {
+		name     string
+		result   bool
+		expected bool
+	}{
+		{name: "Exists on empty List", result: emptyList.Exists(evenPredicate), expected: false},
+		{name: "Exists with match", result: multipleElementsList.Exists(evenPredicate), expected: true},
+		{name: "Exists without match", result: singleElementList.Exists(func(value int) bool { return value == 5 }), expected: false},
+		{name: "ForAll on empty List", result: emptyList.ForAll(evenPredicate), expected: true},
+		{name: "ForAll with all matching", result: singleElementList.ForAll(evenPredicate), expected: true},
+		{name: "ForAll with some not matching", result: multipleElementsList.ForAll(evenPredicate), expected: false},
+		{name: "Contains on empty List", result: emptyList.Contains(10), expected: false},
+		{name: "Contains existing value", result: multipleElementsList.Contains(3), expected: true},
+		{name: "Contains missing value", result: multipleElementsList.Contains(10), expected: false},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.result, testCase.expected, fmt.Sprintf("expected %t but value is %t", testCase.expected, testCase.result))
+		})
+	}
+}
+
+func TestForEachWhile(t *testing.T) {
+	var visited []int
+	multipleElementsList.ForEachWhile(func(value int) bool {
+		visited = append(visited, value)
+		return value < 3
+	})
+	assert.DeepEqual(t, visited, []int{1, 2, 3})
+
+	emptyList.ForEachWhile(func(value int) bool {
+		t.Fatalf("unexpected call with %d on empty List", value)
+		return true
+	})
+}
+
+func TestUpdateInList(t *testing.T) {
+	testCases := []struct {
+		name         string
+		original     List[int]
+		index        int
+		expected     List[int]
+		checkError   bool
+		errorMessage string
+	}{
+		{
+			name:         "Empty List index 0",
+			original:     emptyList,
+			index:        0,
+			checkError:   true,
+			errorMessage: "index out of range 0 on empty List",
+		},
+		{
+			name:       "Single Element List index 0",
+			original:   singleElementList,
+			index:      0,
+			expected:   Of[int](7),
+			checkError: false,
+		},
+		{
+			name:         "Single Element List index 1",
+			original:     singleElementList,
+			index:        1,
+			checkError:   true,
+			errorMessage: "index out of range 0 on empty List",
+		},
+		{
+			name:       "Multiple Elements List index 3",
+			original:   multipleElementsList,
+			index:      3,
+			expected:   OfSlice[int]([]int{1, 2, 3, 7, 5}),
+			checkError: false,
+		},
+		{
+			name:         "Multiple Elements List negative index",
+			original:     multipleElementsList,
+			index:        -1,
+			checkError:   true,
+			errorMessage: "index out of range -1 on List",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := testCase.original.Update(testCase.index, 7)
+			if testCase.checkError {
+				assert.Error(t, err, testCase.errorMessage, "index of range error was expected")
+			} else {
+				assert.NilError(t, err)
+				assert.Assert(t, result.Equals(testCase.expected), fmt.Sprintf("expected %+v but value is %+v", testCase.expected, result))
+			}
+		})
+	}
+}
+
+func TestRemoveAtFromList(t *testing.T) {
+	testCases := []struct {
+		name         string
+		original     List[int]
+		index        int
+		expected     List[int]
+		checkError   bool
+		errorMessage string
+	}{
+		{
+			name:         "Empty List index 0",
+			original:     emptyList,
+			index:        0,
+			checkError:   true,
+			errorMessage: "index out of range 0 on empty List",
+		},
+		{
+			name:       "Single Element List index 0",
+			original:   singleElementList,
+			index:      0,
+			expected:   Empty[int](),
+			checkError: false,
+		},
+		{
+			name:       "Multiple Elements List index 4",
+			original:   multipleElementsList,
+			index:      4,
+			expected:   OfSlice[int]([]int{1, 2, 3, 4}),
+			checkError: false,
+		},
+		{
+			name:         "Multiple Elements List index out of bounds",
+			original:     multipleElementsList,
+			index:        5,
+			checkError:   true,
+			errorMessage: "index out of range 0 on empty List",
+		},
+		{
+			name:         "Multiple Elements List negative index",
+			original:     multipleElementsList,
+			index:        -2,
+			checkError:   true,
+			errorMessage: "index out of range -2 on List",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := testCase.original.RemoveAt(testCase.index)
+			if testCase.checkError {
+				assert.Error(t, err, testCase.errorMessage, "index of range error was expected")
+			} else {
+				assert.NilError(t, err)
+				assert.Assert(t, result.Equals(testCase.expected), fmt.Sprintf("expected %+v but value is %+v", testCase.expected, result))
+			}
+		})
+	}
+}
+
+func TestFlatMapList(t *testing.T) {
+	var mapper = func(value int) List[string] {
+		return OfSlice([]string{strconv.Itoa(value), strconv.Itoa(value * 10)})
+	}
+	testCases := []struct {
+		name     string
+		value    List[int]
+		expected List[string]
+	}{
+		{
+			name:     "Empty List",
+			value:    emptyList,
+			expected: Empty[string](),
+		},
+		{
+			name:     "Single Element List",
+			value:    singleElementList,
+			expected: OfSlice([]string{"10", "100"}),
+		},
+		{
+			name:     "Multiple Elements List",
+			value:    OfSlice([]int{1, 2, 3}),
+			expected: OfSlice([]string{"1", "10", "2", "20", "3", "30"}),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := FlatMapList[int, string](testCase.value, mapper)
+			assert.Assert(t, result.Equals(testCase.expected), fmt.Sprintf("expected %+v but value is %+v", testCase.expected, result))
+		})
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    List[List[int]]
+		expected List[int]
+	}{
+		{
+			name:     "Empty List",
+			value:    Empty[List[int]](),
+			expected: Empty[int](),
+		},
+		{
+			name:     "List of empty Lists",
+			value:    OfSlice([]List[int]{emptyList, emptyList}),
+			expected: Empty[int](),
+		},
+		{
+			name:     "List of Lists",
+			value:    OfSlice([]List[int]{singleElementList, emptyList, multipleElementsList}),
+			expected: OfSlice([]int{10, 1, 2, 3, 4, 5}),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := Flatten(testCase.value)
+			assert.Assert(t, result.Equals(testCase.expected), fmt.Sprintf("expected %+v but value is %+v", testCase.expected, result))
+		})
+	}
+}
+
+func TestIntersperse(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    List[int]
+		expected List[int]
+	}{
+		{
+			name:     "Empty List",
+			value:    emptyList,
+			expected: Empty[int](),
+		},
+		{
+			name:     "Single Element List",
+			value:    singleElementList,
+			expected: Of(10),
+		},
+		{
+			name:     "Multiple Elements List",
+			value:    OfSlice([]int{1, 2, 3}),
+			expected: OfSlice([]int{1, 0, 2, 0, 3}),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := testCase.value.Intersperse(0)
+			assert.Assert(t, result.Equals(testCase.expected), fmt.Sprintf("expected %+v but value is %+v", testCase.expected, result))
+		})
+	}
+}
+
+func TestInterleave(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    List[int]
+		other    List[int]
+		expected List[int]
+	}{
+		{
+			name:     "Empty Lists",
+			value:    emptyList,
+			other:    emptyList,
+			expected: Empty[int](),
+		},
+		{
+			name:     "Empty List with other List",
+			value:    emptyList,
+			other:    singleElementList,
+			expected: Of(10),
+		},
+		{
+			name:     "Longer current List",
+			value:    multipleElementsList,
+			other:    OfSlice([]int{10, 20}),
+			expected: OfSlice([]int{1, 10, 2, 20, 3, 4, 5}),
+		},
+		{
+			name:     "Longer other List",
+			value:    singleElementList,
+			other:    OfSlice([]int{1, 2, 3}),
+			expected: OfSlice([]int{10, 1, 2, 3}),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := testCase.value.Interleave(testCase.other)
+			assert.Assert(t, result.Equals(testCase.expected), fmt.Sprintf("expected %+v but value is %+v", testCase.expected, result))
+		})
+	}
+}
+
+var (
+	benchmarkList = Tabulate(10000, func(i int) int { return i })
+	listSink      List[int]
+	intSink       int
+)
+
+func BenchmarkOfSlice(b *testing.B) {
+	elements := make([]int, 10000)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		listSink = OfSlice(elements)
+	}
+}
+
+func BenchmarkTraversal(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		intSink = Sum(benchmarkList)
+	}
+}
+
+func BenchmarkFilter(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		listSink = benchmarkList.Filter(evenPredicate)
+	}
+}
+
+func BenchmarkGet(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		intSink = benchmarkList.Get(9999).OrElse(0)
+	}
+}
@@ -0,0 +1,133 @@
+package collection
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"glours/go2funk/api"
+	"glours/go2funk/api/control"
+)
+
+// Sum returns the sum of the elements of the List[T], 0 for an empty List.
+func Sum[T api.Number](list List[T]) T {
+	var sum T
+	for current := list.first; current != nil; current = current.next {
+		sum += current.value
+	}
+	return sum
+}
+
+// Product returns the product of the elements of the List[T], 1 for an empty List.
+func Product[T api.Number](list List[T]) T {
+	product := T(1)
+	for current := list.first; current != nil; current = current.next {
+		product *= current.value
+	}
+	return product
+}
+
+// Min returns an Option containing the smallest element of the List[T] or an empty Option if the list is empty.
+func Min[T api.Ordered](list List[T]) control.Option[T] {
+	return MinBy(list, identity[T])
+}
+
+// Max returns an Option containing the greatest element of the List[T] or an empty Option if the list is empty.
+func Max[T api.Ordered](list List[T]) control.Option[T] {
+	return MaxBy(list, identity[T])
+}
+
+// MinBy returns an Option containing the first element of the List[T] with the smallest key or an empty Option if the list is empty.
+func MinBy[T any, K api.Ordered](list List[T], key func(T) K) control.Option[T] {
+	return selectBy(list, key, func(candidate K, selected K) bool {
+		return candidate < selected
+	})
+}
+
+// MaxBy returns an Option containing the first element of the List[T] with the greatest key or an empty Option if the list is empty.
+func MaxBy[T any, K api.Ordered](list List[T], key func(T) K) control.Option[T] {
+	return selectBy(list, key, func(candidate K, selected K) bool {
+		return candidate > selected
+	})
+}
+
+// Average returns an Option containing the arithmetic mean of the elements of the List[T] or an empty Option if the list is empty.
+func Average[T api.Number](list List[T]) control.Option[float64] {
+	if list.IsEmpty() {
+		return control.Empty[float64]()
+	}
+	sum := 0.0
+	for current := list.first; current != nil; current = current.next {
+		sum += float64(current.value)
+	}
+	return control.Of(sum / float64(list.Length()))
+}
+
+// Median returns an Option containing the median of the elements of the List[T] or an empty Option if the list is empty.
+// for lists with an even number of elements, the mean of the two middle elements is returned.
+func Median[T api.Number](list List[T]) control.Option[float64] {
+	median, _ := Percentile(list, 50)
+	return median
+}
+
+// Variance returns an Option containing the population variance of the elements of the List[T] or an empty Option if the list is empty.
+func Variance[T api.Number](list List[T]) control.Option[float64] {
+	if list.IsEmpty() {
+		return control.Empty[float64]()
+	}
+	// Welford's online algorithm keeps the computation numerically stable
+	mean, squares, count := 0.0, 0.0, 0.0
+	for current := list.first; current != nil; current = current.next {
+		count++
+		value := float64(current.value)
+		delta := value - mean
+		mean += delta / count
+		squares += delta * (value - mean)
+	}
+	return control.Of(squares / count)
+}
+
+// StdDev returns an Option containing the population standard deviation of the elements of the List[T] or an empty Option if the list is empty.
+func StdDev[T api.Number](list List[T]) control.Option[float64] {
+	return control.MapOption(Variance(list), math.Sqrt)
+}
+
+// Percentile returns an Option containing the p-th percentile of the elements of the List[T] or an empty Option if the list is empty.
+// the percentile is linearly interpolated between the two closest ranks.
+// this function returns error if p is less than 0 or greater than 100.
+func Percentile[T api.Number](list List[T], p float64) (control.Option[float64], error) {
+	if p < 0 || p > 100 || math.IsNaN(p) {
+		return control.Empty[float64](), fmt.Errorf("invalid percentile %v, should be between 0 and 100", p)
+	}
+	if list.IsEmpty() {
+		return control.Empty[float64](), nil
+	}
+	values := make([]float64, 0, list.Length())
+	for current := list.first; current != nil; current = current.next {
+		values = append(values, float64(current.value))
+	}
+	sort.Float64s(values)
+	rank := p / 100 * float64(len(values)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	return control.Of(values[lower] + (values[upper]-values[lower])*(rank-float64(lower))), nil
+}
+
+// selectBy is an internal function returning the first element of the list whose key is preferred over the keys of all the others.
+func selectBy[T any, K api.Ordered](list List[T], key func(T) K, prefer func(candidate K, selected K) bool) control.Option[T] {
+	if list.IsEmpty() {
+		return control.Empty[T]()
+	}
+	selected, selectedKey := list.head(), key(list.head())
+	for current := list.first.next; current != nil; current = current.next {
+		if candidateKey := key(current.value); prefer(candidateKey, selectedKey) {
+			selected, selectedKey = current.value, candidateKey
+		}
+	}
+	return control.Of(selected)
+}
+
+// identity is an internal function returning the value passed as parameter.
+func identity[T any](value T) T {
+	return value
+}
@@ -0,0 +1,101 @@
+package collection
+
+import (
+	"fmt"
+	"glours/go2funk/api/control"
+	"gotest.tools/v3/assert"
+	"testing"
+)
+
+var (
+	floatList = OfSlice([]float64{2.5, -1, 4, 0.5})
+)
+
+func TestSumAndProduct(t *testing.T) {
+	assert.Equal(t, Sum(emptyList), 0)
+	assert.Equal(t, Sum(multipleElementsList), 15)
+	assert.Equal(t, Sum(floatList), 6.0)
+
+	assert.Equal(t, Product(emptyList), 1)
+	assert.Equal(t, Product(multipleElementsList), 120)
+	assert.Equal(t, Product(floatList), -5.0)
+}
+
+func TestMinAndMax(t *testing.T) {
+	testCases := []struct {
+		name     string
+		result   control.Option[int]
+		expected control.Option[int]
+	}{
+		{name: "Min of empty List", result: Min(emptyList), expected: control.Empty[int]()},
+		{name: "Min", result: Min(OfSlice([]int{3, 1, 2})), expected: control.Of(1)},
+		{name: "Max of empty List", result: Max(emptyList), expected: control.Empty[int]()},
+		{name: "Max", result: Max(OfSlice([]int{3, 1, 2})), expected: control.Of(3)},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.result, testCase.expected, fmt.Sprintf("expected %+v but value is %+v", testCase.expected, testCase.result))
+		})
+	}
+	assert.Equal(t, Min(OfSlice([]string{"b", "a", "c"})), control.Of("a"))
+}
+
+func TestMinByAndMaxBy(t *testing.T) {
+	words := OfSlice([]string{"kiwi", "fig", "banana", "pear", "cherry"})
+	length := func(value string) int { return len(value) }
+
+	assert.Equal(t, MinBy(words, length), control.Of("fig"))
+	assert.Equal(t, MaxBy(words, length), control.Of("banana"), "first greatest element should be returned")
+	assert.Assert(t, MinBy(Empty[string](), length).IsEmpty())
+	assert.Assert(t, MaxBy(Empty[string](), length).IsEmpty())
+}
+
+func TestStatistics(t *testing.T) {
+	values := OfSlice([]int{2, 4, 4, 4, 5, 5, 7, 9})
+	testCases := []struct {
+		name     string
+		result   control.Option[float64]
+		expected control.Option[float64]
+	}{
+		{name: "Average of empty List", result: Average(emptyList), expected: control.Empty[float64]()},
+		{name: "Average", result: Average(values), expected: control.Of(5.0)},
+		{name: "Median of empty List", result: Median(emptyList), expected: control.Empty[float64]()},
+		{name: "Median of odd length List", result: Median(OfSlice([]int{5, 1, 3})), expected: control.Of(3.0)},
+		{name: "Median of even length List", result: Median(values), expected: control.Of(4.5)},
+		{name: "Variance of empty List", result: Variance(emptyList), expected: control.Empty[float64]()},
+		{name: "Variance", result: Variance(values), expected: control.Of(4.0)},
+		{name: "StdDev of empty List", result: StdDev(emptyList), expected: control.Empty[float64]()},
+		{name: "StdDev", result: StdDev(values), expected: control.Of(2.0)},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.result, testCase.expected, fmt.Sprintf("expected %+v but value is %+v", testCase.expected, testCase.result))
+		})
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	values := OfSlice([]int{15, 20, 35, 40, 50})
+	testCases := []struct {
+		name       string
+		list       List[int]
+		percentile float64
+		expected   control.Option[float64]
+	}{
+		{name: "Empty List", list: emptyList, percentile: 50, expected: control.Empty[float64]()},
+		{name: "Lowest percentile", list: values, percentile: 0, expected: control.Of(15.0)},
+		{name: "Highest percentile", list: values, percentile: 100, expected: control.Of(50.0)},
+		{name: "Exact rank", list: values, percentile: 75, expected: control.Of(40.0)},
+		{name: "Interpolated rank", list: values, percentile: 40, expected: control.Of(29.0)},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := Percentile(testCase.list, testCase.percentile)
+			assert.NilError(t, err)
+			assert.Equal(t, result, testCase.expected, fmt.Sprintf("expected %+v but value is %+v", testCase.expected, result))
+		})
+	}
+
+	_, err := Percentile(values, 101)
+	assert.Error(t, err, "invalid percentile 101, should be between 0 and 100")
+}
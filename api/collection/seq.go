@@ -0,0 +1,238 @@
+package collection
+
+import (
+	"reflect"
+
+	"glours/go2funk/api/control"
+)
+
+// Traversable is implemented by the collections whose elements can be visited, such as sets or maps.
+type Traversable[T any] interface {
+	// ForEachWhile calls the function passed as parameter on each element until it returns false.
+	ForEachWhile(f func(T) bool)
+	// Length returns the number of elements.
+	Length() int
+	// IsEmpty checks if there is no element.
+	IsEmpty() bool
+	// Exists checks if at least one element is validating the predicate passed as parameter.
+	Exists(predicate func(T) bool) bool
+	// ForAll checks if all the elements are validating the predicate passed as parameter.
+	ForAll(predicate func(T) bool) bool
+	// Count returns the number of elements validating the predicate passed as parameter.
+	Count(predicate func(T) bool) int
+	// Find returns an Option containing the first element visited which is validating the predicate passed as parameter.
+	Find(predicate func(T) bool) control.Option[T]
+	// Contains checks if an element matches the value passed as parameter.
+	Contains(value T) bool
+}
+
+// Seq is implemented by the Traversable collections whose elements are ordered and reachable by their index, such as List.
+type Seq[T any] interface {
+	Traversable[T]
+	// Get returns an Option containing the element at the position matching the index.
+	Get(index int) control.Option[T]
+	// HeadOption returns an Option containing the first element.
+	HeadOption() control.Option[T]
+	// LastOption returns an Option containing the last element.
+	LastOption() control.Option[T]
+	// IndexOf returns the index of the first element matching the value passed as parameter or -1 if there is none.
+	IndexOf(value T) int
+	// IndexWhere returns the index of the first element validating the predicate passed as parameter or -1 if there is none.
+	IndexWhere(predicate func(T) bool) int
+	// LastIndexWhere returns the index of the last element validating the predicate passed as parameter or -1 if there is none.
+	LastIndexWhere(predicate func(T) bool) int
+}
+
+var _ Seq[int] = List[int]{}
+
+// MapSeq maps the elements of the Traversable[T] to a List of elements of a new type U preserving their order.
+func MapSeq[T, U any](traversable Traversable[T], mapper func(T) U) List[U] {
+	var result appender[U]
+	traversable.ForEachWhile(func(value T) bool {
+		result.add(mapper(value))
+		return true
+	})
+	return result.result(Empty[U]())
+}
+
+// FilterSeq returns a List containing only the elements of the Traversable[T] which are validating the predicate passed as parameter.
+func FilterSeq[T any](traversable Traversable[T], predicate func(T) bool) List[T] {
+	var result appender[T]
+	traversable.ForEachWhile(func(value T) bool {
+		if predicate(value) {
+			result.add(value)
+		}
+		return true
+	})
+	return result.result(Empty[T]())
+}
+
+// FoldSeq combines the elements of the Traversable[T] in the order they are visited with the folder function, starting from zero.
+func FoldSeq[T, U any](traversable Traversable[T], zero U, folder func(U, T) U) U {
+	result := zero
+	traversable.ForEachWhile(func(value T) bool {
+		result = folder(result, value)
+		return true
+	})
+	return result
+}
+
+// FindSeq returns an Option containing the first element of the Traversable[T] which is validating the predicate passed as parameter.
+func FindSeq[T any](traversable Traversable[T], predicate func(T) bool) control.Option[T] {
+	result := control.Empty[T]()
+	traversable.ForEachWhile(func(value T) bool {
+		if predicate(value) {
+			result = control.Of(value)
+			return false
+		}
+		return true
+	})
+	return result
+}
+
+// ToSlice returns a new slice containing the elements of the Traversable[T] in the order they are visited.
+func ToSlice[T any](traversable Traversable[T]) []T {
+	result := make([]T, 0, traversable.Length())
+	traversable.ForEachWhile(func(value T) bool {
+		result = append(result, value)
+		return true
+	})
+	return result
+}
+
+// TraversableOps provides default implementations of the Traversable methods built on a ForEachWhile function.
+// a new collection can embed it and only needs to override the methods it can implement more efficiently, such as Length.
+type TraversableOps[T any] struct {
+	forEachWhile func(func(T) bool)
+}
+
+// NewTraversableOps returns the default Traversable methods built on the forEachWhile function passed as parameter.
+func NewTraversableOps[T any](forEachWhile func(f func(T) bool)) TraversableOps[T] {
+	return TraversableOps[T]{forEachWhile: forEachWhile}
+}
+
+// ForEachWhile calls the function passed as parameter on each element until it returns false.
+func (o TraversableOps[T]) ForEachWhile(f func(T) bool) {
+	if o.forEachWhile != nil {
+		o.forEachWhile(f)
+	}
+}
+
+// Length returns the number of elements by visiting all of them.
+func (o TraversableOps[T]) Length() int {
+	return o.Count(func(T) bool { return true })
+}
+
+// IsEmpty checks if there is no element.
+func (o TraversableOps[T]) IsEmpty() bool {
+	return !o.Exists(func(T) bool { return true })
+}
+
+// Exists checks if at least one element is validating the predicate passed as parameter.
+func (o TraversableOps[T]) Exists(predicate func(T) bool) bool {
+	return !o.Find(predicate).IsEmpty()
+}
+
+// ForAll checks if all the elements are validating the predicate passed as parameter.
+// it always returns true when there is no element.
+func (o TraversableOps[T]) ForAll(predicate func(T) bool) bool {
+	return !o.Exists(func(value T) bool {
+		return !predicate(value)
+	})
+}
+
+// Count returns the number of elements validating the predicate passed as parameter.
+func (o TraversableOps[T]) Count(predicate func(T) bool) int {
+	return FoldSeq[T](o, 0, func(count int, value T) int {
+		if predicate(value) {
+			return count + 1
+		}
+		return count
+	})
+}
+
+// Find returns an Option containing the first element visited which is validating the predicate passed as parameter.
+func (o TraversableOps[T]) Find(predicate func(T) bool) control.Option[T] {
+	return FindSeq[T](o, predicate)
+}
+
+// Contains checks if an element matches the value passed as parameter.
+// elements are compared with reflect.DeepEqual.
+func (o TraversableOps[T]) Contains(value T) bool {
+	return o.Exists(func(element T) bool {
+		return reflect.DeepEqual(element, value)
+	})
+}
+
+// SeqOps provides default implementations of the Seq methods built on a ForEachWhile function visiting the elements in order.
+type SeqOps[T any] struct {
+	TraversableOps[T]
+}
+
+// NewSeqOps returns the default Seq methods built on the forEachWhile function passed as parameter.
+func NewSeqOps[T any](forEachWhile func(f func(T) bool)) SeqOps[T] {
+	return SeqOps[T]{NewTraversableOps(forEachWhile)}
+}
+
+// Get returns an Option containing the element at the position matching the index.
+// an empty Option is returned if the index is less than 0 or greater than or equal to the number of elements.
+func (o SeqOps[T]) Get(index int) control.Option[T] {
+	if index < 0 {
+		return control.Empty[T]()
+	}
+	position := 0
+	return o.Find(func(T) bool {
+		position++
+		return position > index
+	})
+}
+
+// HeadOption returns an Option containing the first element.
+func (o SeqOps[T]) HeadOption() control.Option[T] {
+	return o.Get(0)
+}
+
+// LastOption returns an Option containing the last element.
+func (o SeqOps[T]) LastOption() control.Option[T] {
+	return FoldSeq[T](o, control.Empty[T](), func(_ control.Option[T], value T) control.Option[T] {
+		return control.Of(value)
+	})
+}
+
+// IndexOf returns the index of the first element matching the value passed as parameter or -1 if there is none.
+func (o SeqOps[T]) IndexOf(value T) int {
+	return o.IndexWhere(func(element T) bool {
+		return reflect.DeepEqual(element, value)
+	})
+}
+
+// IndexWhere returns the index of the first element validating the predicate passed as parameter or -1 if there is none.
+func (o SeqOps[T]) IndexWhere(predicate func(T) bool) int {
+	index, found := 0, false
+	o.ForEachWhile(func(value T) bool {
+		found = predicate(value)
+		if !found {
+			index++
+		}
+		return !found
+	})
+	if !found {
+		return -1
+	}
+	return index
+}
+
+// LastIndexWhere returns the index of the last element validating the predicate passed as parameter or -1 if there is none.
+func (o SeqOps[T]) LastIndexWhere(predicate func(T) bool) int {
+	last, index := -1, 0
+	o.ForEachWhile(func(value T) bool {
+		if predicate(value) {
+			last = index
+		}
+		index++
+		return true
+	})
+	return last
+}
+
+var _ Seq[int] = SeqOps[int]{}
@@ -0,0 +1,90 @@
+package collection
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"glours/go2funk/api/control"
+	"gotest.tools/v3/assert"
+)
+
+// sliceSeq is a Seq backed by a slice relying on the default implementations of SeqOps.
+type sliceSeq[T any] struct {
+	SeqOps[T]
+	values []T
+}
+
+func newSliceSeq[T any](values ...T) sliceSeq[T] {
+	seq := sliceSeq[T]{values: values}
+	seq.SeqOps = NewSeqOps(func(f func(T) bool) {
+		for _, value := range values {
+			if !f(value) {
+				return
+			}
+		}
+	})
+	return seq
+}
+
+func (s sliceSeq[T]) Length() int {
+	return len(s.values)
+}
+
+var _ Seq[int] = sliceSeq[int]{}
+
+func TestSeqFunctions(t *testing.T) {
+	testCases := []struct {
+		name string
+		seq  Seq[int]
+	}{
+		{name: "List", seq: multipleElementsList},
+		{name: "SeqOps", seq: newSliceSeq(1, 2, 3, 4, 5)},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mapped := MapSeq[int](testCase.seq, strconv.Itoa)
+			assert.Assert(t, mapped.Equals(OfSlice([]string{"1", "2", "3", "4", "5"})), fmt.Sprintf("unexpected value %v", mapped))
+			filtered := FilterSeq[int](testCase.seq, evenPredicate)
+			assert.Assert(t, filtered.Equals(OfSlice([]int{2, 4})), fmt.Sprintf("unexpected value %v", filtered))
+			assert.Equal(t, FoldSeq[int](testCase.seq, 0, func(acc int, value int) int { return acc + value }), 15)
+			assert.Equal(t, FindSeq[int](testCase.seq, evenPredicate), control.Of(2))
+			assert.Equal(t, FindSeq[int](testCase.seq, func(value int) bool { return value > 5 }), control.Empty[int]())
+			assert.DeepEqual(t, ToSlice[int](testCase.seq), []int{1, 2, 3, 4, 5})
+		})
+	}
+}
+
+func TestSeqOps(t *testing.T) {
+	testCases := []struct {
+		name     string
+		list     List[int]
+		expected Seq[int]
+	}{
+		{name: "Empty", list: emptyList, expected: newSliceSeq[int]()},
+		{name: "Single element", list: singleElementList, expected: newSliceSeq(10)},
+		{name: "Multiple elements", list: OfSlice([]int{1, 2, 3, 2, 1}), expected: newSliceSeq(1, 2, 3, 2, 1)},
+		{name: "Zero value", list: emptyList, expected: SeqOps[int]{}},
+	}
+	isTwo := func(value int) bool { return value == 2 }
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			seq, list := testCase.expected, testCase.list
+			assert.Equal(t, seq.Length(), list.Length())
+			assert.Equal(t, seq.IsEmpty(), list.IsEmpty())
+			assert.Equal(t, seq.Exists(isTwo), list.Exists(isTwo))
+			assert.Equal(t, seq.ForAll(isTwo), list.ForAll(isTwo))
+			assert.Equal(t, seq.Count(isTwo), list.Count(isTwo))
+			assert.Equal(t, seq.Find(isTwo), list.Find(isTwo))
+			assert.Equal(t, seq.Contains(3), list.Contains(3))
+			for index := -1; index <= list.Length(); index++ {
+				assert.Equal(t, seq.Get(index), list.Get(index), fmt.Sprintf("unexpected value at index %d", index))
+			}
+			assert.Equal(t, seq.HeadOption(), list.HeadOption())
+			assert.Equal(t, seq.LastOption(), list.LastOption())
+			assert.Equal(t, seq.IndexOf(2), list.IndexOf(2))
+			assert.Equal(t, seq.IndexWhere(isTwo), list.IndexWhere(isTwo))
+			assert.Equal(t, seq.LastIndexWhere(isTwo), list.LastIndexWhere(isTwo))
+		})
+	}
+}
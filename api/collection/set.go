@@ -0,0 +1,108 @@
+package collection
+
+import "reflect"
+
+// DistinctBy returns a new list keeping only the first element of each group of elements sharing the same key.
+// the key function should take a T value and return a comparable K value.
+func DistinctBy[T any, K comparable](list List[T], key func(T) K) List[T] {
+	seen := make(map[K]struct{}, list.Length())
+	var result appender[T]
+	for current := list.first; current != nil; current = current.next {
+		k := key(current.value)
+		if _, found := seen[k]; !found {
+			seen[k] = struct{}{}
+			result.add(current.value)
+		}
+	}
+	return result.result(Empty[T]())
+}
+
+// Distinct returns a new list without the duplicated elements of the current list, keeping the first occurrence of each.
+func (l List[T]) Distinct() List[T] {
+	return l.DistinctWith(deepEqual[T])
+}
+
+// DistinctWith returns a new list without the duplicated elements of the current list, keeping the first occurrence of each.
+// elements are compared with the equality function passed as parameter.
+func (l List[T]) DistinctWith(equal func(T, T) bool) List[T] {
+	var elements []T
+	var result appender[T]
+	for current := l.first; current != nil; current = current.next {
+		if indexOf(elements, current.value, equal) < 0 {
+			elements = append(elements, current.value)
+			result.add(current.value)
+		}
+	}
+	return result.result(Empty[T]())
+}
+
+// Union returns a new list with the elements of the current list followed by the elements of the other list passed as parameter
+// which are not already in the current list, following multiset semantics.
+func (l List[T]) Union(other List[T]) List[T] {
+	return l.UnionWith(other, deepEqual[T])
+}
+
+// UnionWith returns a new list with the elements of the current list followed by the elements of the other list passed as parameter
+// which are not already in the current list, following multiset semantics.
+// elements are compared with the equality function passed as parameter.
+func (l List[T]) UnionWith(other List[T], equal func(T, T) bool) List[T] {
+	return Concat(l, other.DiffWith(l, equal))
+}
+
+// Intersect returns a new list with the elements of the current list which are also in the other list passed as parameter,
+// following multiset semantics and preserving the order of the current list.
+func (l List[T]) Intersect(other List[T]) List[T] {
+	return l.IntersectWith(other, deepEqual[T])
+}
+
+// IntersectWith returns a new list with the elements of the current list which are also in the other list passed as parameter,
+// following multiset semantics and preserving the order of the current list.
+// elements are compared with the equality function passed as parameter.
+func (l List[T]) IntersectWith(other List[T], equal func(T, T) bool) List[T] {
+	return multisetFilter(l, other, equal, true)
+}
+
+// Diff returns a new list with the elements of the current list which are not in the other list passed as parameter,
+// following multiset semantics and preserving the order of the current list.
+func (l List[T]) Diff(other List[T]) List[T] {
+	return l.DiffWith(other, deepEqual[T])
+}
+
+// DiffWith returns a new list with the elements of the current list which are not in the other list passed as parameter,
+// following multiset semantics and preserving the order of the current list.
+// elements are compared with the equality function passed as parameter.
+func (l List[T]) DiffWith(other List[T], equal func(T, T) bool) List[T] {
+	return multisetFilter(l, other, equal, false)
+}
+
+// multisetFilter is an internal function keeping the elements of the list which have (or have not) a matching element in the other list.
+// each element of the other list can only match a single element of the list.
+func multisetFilter[T any](list List[T], other List[T], equal func(T, T) bool, keepMatching bool) List[T] {
+	remaining := toSlice(other)
+	var result appender[T]
+	for current := list.first; current != nil; current = current.next {
+		index := indexOf(remaining, current.value, equal)
+		if index >= 0 {
+			remaining = append(remaining[:index], remaining[index+1:]...)
+		}
+		if (index >= 0) == keepMatching {
+			result.add(current.value)
+		}
+	}
+	return result.result(Empty[T]())
+}
+
+// indexOf is an internal function returning the index of the first element of the slice equal to the value or -1 if there is none.
+func indexOf[T any](elements []T, value T, equal func(T, T) bool) int {
+	for i, element := range elements {
+		if equal(element, value) {
+			return i
+		}
+	}
+	return -1
+}
+
+// deepEqual is an internal function comparing two values with reflect.DeepEqual.
+func deepEqual[T any](left T, right T) bool {
+	return reflect.DeepEqual(left, right)
+}
@@ -0,0 +1,107 @@
+package collection
+
+import (
+	"fmt"
+	"gotest.tools/v3/assert"
+	"strings"
+	"testing"
+)
+
+var (
+	duplicatesList  = OfSlice([]int{1, 2, 1, 3, 2, 1})
+	equalIgnoreCase = func(left string, right string) bool {
+		return strings.EqualFold(left, right)
+	}
+)
+
+func TestDistinct(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    List[int]
+		expected List[int]
+	}{
+		{
+			name:     "Empty List",
+			value:    emptyList,
+			expected: Empty[int](),
+		},
+		{
+			name:     "List without duplicates",
+			value:    multipleElementsList,
+			expected: multipleElementsList,
+		},
+		{
+			name:     "List with duplicates",
+			value:    duplicatesList,
+			expected: OfSlice([]int{1, 2, 3}),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := testCase.value.Distinct()
+			assert.Assert(t, result.Equals(testCase.expected), fmt.Sprintf("expected %+v but value is %+v", testCase.expected, result))
+		})
+	}
+}
+
+func TestDistinctWith(t *testing.T) {
+	result := OfSlice([]string{"a", "B", "A", "b", "c"}).DistinctWith(equalIgnoreCase)
+	expected := OfSlice([]string{"a", "B", "c"})
+	assert.Assert(t, result.Equals(expected), fmt.Sprintf("expected %+v but value is %+v", expected, result))
+}
+
+func TestDistinctBy(t *testing.T) {
+	type record struct {
+		id    int
+		label string
+	}
+	records := OfSlice([]record{{1, "first"}, {2, "second"}, {1, "duplicate"}})
+	result := DistinctBy(records, func(r record) int { return r.id })
+	expected := OfSlice([]record{{1, "first"}, {2, "second"}})
+	assert.Assert(t, result.Equals(expected), fmt.Sprintf("expected %+v but value is %+v", expected, result))
+
+	assert.Equal(t, DistinctBy(emptyList, func(value int) int { return value }), emptyList)
+}
+
+func TestSetOperations(t *testing.T) {
+	other := OfSlice([]int{1, 1, 4, 2})
+	testCases := []struct {
+		name     string
+		result   List[int]
+		expected List[int]
+	}{
+		{name: "Union with empty List", result: emptyList.Union(other), expected: other},
+		{name: "Union of empty List", result: duplicatesList.Union(emptyList), expected: duplicatesList},
+		{name: "Union", result: duplicatesList.Union(other), expected: OfSlice([]int{1, 2, 1, 3, 2, 1, 4})},
+		{name: "Intersect with empty List", result: duplicatesList.Intersect(emptyList), expected: emptyList},
+		{name: "Intersect of empty List", result: emptyList.Intersect(other), expected: emptyList},
+		{name: "Intersect", result: duplicatesList.Intersect(other), expected: OfSlice([]int{1, 2, 1})},
+		{name: "Diff with empty List", result: duplicatesList.Diff(emptyList), expected: duplicatesList},
+		{name: "Diff of empty List", result: emptyList.Diff(other), expected: emptyList},
+		{name: "Diff", result: duplicatesList.Diff(other), expected: OfSlice([]int{3, 2, 1})},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Assert(t, testCase.result.Equals(testCase.expected), fmt.Sprintf("expected %+v but value is %+v", testCase.expected, testCase.result))
+		})
+	}
+}
+
+func TestSetOperationsWithEquality(t *testing.T) {
+	list := OfSlice([]string{"a", "b", "C"})
+	other := OfSlice([]string{"c", "A", "d"})
+	testCases := []struct {
+		name     string
+		result   List[string]
+		expected List[string]
+	}{
+		{name: "UnionWith", result: list.UnionWith(other, equalIgnoreCase), expected: OfSlice([]string{"a", "b", "C", "d"})},
+		{name: "IntersectWith", result: list.IntersectWith(other, equalIgnoreCase), expected: OfSlice([]string{"a", "C"})},
+		{name: "DiffWith", result: list.DiffWith(other, equalIgnoreCase), expected: Of("b")},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Assert(t, testCase.result.Equals(testCase.expected), fmt.Sprintf("expected %+v but value is %+v", testCase.expected, testCase.result))
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package collection
+
+import (
+	"fmt"
+	"strings"
+
+	"glours/go2funk/api/internal/gostring"
+)
+
+// maxStringElements is the number of elements printed by String before truncating the List.
+const maxStringElements = 100
+
+// MkString returns a string with the elements of the current list separated by separator, between prefix and suffix.
+func (l List[T]) MkString(separator string, prefix string, suffix string) string {
+	return mkString(l, separator, prefix, suffix, -1)
+}
+
+// String returns a readable representation of the List as "List(1, 2, 3)", "List()" for an empty List.
+// only the first elements of very long lists are printed.
+func (l List[T]) String() string {
+	return mkString(l, ", ", "List(", ")", maxStringElements)
+}
+
+// GoString returns the Go syntax representation of the List.
+func (l List[T]) GoString() string {
+	if l.IsEmpty() {
+		return fmt.Sprintf("collection.Empty[%s]()", gostring.TypeName[T]())
+	}
+	elements := make([]string, 0, l.length)
+	for current := l.first; current != nil; current = current.next {
+		elements = append(elements, gostring.Value(current.value))
+	}
+	return fmt.Sprintf("collection.OfSlice([]%s{%s})", gostring.TypeName[T](), strings.Join(elements, ", "))
+}
+
+// mkString is an internal function joining the elements of the list, replacing the elements after limit by "..." if limit is positive.
+func mkString[T any](list List[T], separator string, prefix string, suffix string, limit int) string {
+	var builder strings.Builder
+	builder.WriteString(prefix)
+	count := 0
+	for current := list.first; current != nil; current = current.next {
+		if count > 0 {
+			builder.WriteString(separator)
+		}
+		if count == limit {
+			builder.WriteString("...")
+			break
+		}
+		fmt.Fprint(&builder, current.value)
+		count++
+	}
+	builder.WriteString(suffix)
+	return builder.String()
+}
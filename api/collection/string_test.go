@@ -0,0 +1,64 @@
+package collection
+
+import (
+	"fmt"
+	"glours/go2funk/api"
+	"gotest.tools/v3/assert"
+	"strings"
+	"testing"
+)
+
+func TestListString(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    fmt.Stringer
+		expected string
+	}{
+		{name: "Empty List", value: emptyList, expected: "List()"},
+		{name: "Single Element List", value: singleElementList, expected: "List(10)"},
+		{name: "Multiple Elements List", value: multipleElementsList, expected: "List(1, 2, 3, 4, 5)"},
+		{name: "List of Strings", value: OfSlice([]string{"a", "b"}), expected: "List(a, b)"},
+		{name: "List of Lists", value: OfSlice([]List[int]{singleElementList, emptyList}), expected: "List(List(10), List())"},
+		{name: "List of Pairs", value: Of(api.NewPair(1, "one")), expected: "List((1, one))"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := testCase.value.String()
+			assert.Equal(t, result, testCase.expected, fmt.Sprintf("expected %s but value is %s", testCase.expected, result))
+		})
+	}
+}
+
+func TestLongListStringIsTruncated(t *testing.T) {
+	result := Tabulate(1000, func(i int) int { return i }).String()
+	assert.Assert(t, strings.HasPrefix(result, "List(0, 1, 2, "), result)
+	assert.Assert(t, strings.HasSuffix(result, ", 98, 99, ...)"), result)
+}
+
+func TestListGoString(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    fmt.GoStringer
+		expected string
+	}{
+		{name: "Empty List", value: emptyList, expected: "collection.Empty[int]()"},
+		{name: "Multiple Elements List", value: OfSlice([]int{1, 2}), expected: "collection.OfSlice([]int{1, 2})"},
+		{name: "List of Strings", value: OfSlice([]string{"a", "b"}), expected: `collection.OfSlice([]string{"a", "b"})`},
+		{name: "List of Lists", value: Of(Of(1)), expected: "collection.OfSlice([]collection.List[int]{collection.OfSlice([]int{1})})"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := fmt.Sprintf("%#v", testCase.value)
+			assert.Equal(t, result, testCase.expected, fmt.Sprintf("expected %s but value is %s", testCase.expected, result))
+		})
+	}
+}
+
+func TestMkString(t *testing.T) {
+	assert.Equal(t, emptyList.MkString(", ", "[", "]"), "[]")
+	assert.Equal(t, singleElementList.MkString(", ", "[", "]"), "[10]")
+	assert.Equal(t, multipleElementsList.MkString("-", "", ""), "1-2-3-4-5")
+
+	long := Fill(200, 1).MkString("", "", "")
+	assert.Equal(t, len(long), 200, "MkString should not truncate the list")
+}
@@ -0,0 +1,64 @@
+package collection
+
+import "glours/go2funk/api/control"
+
+// TraverseOption maps each element of the List[T] to an Option[U] and collects the results in an Option[List[U]].
+// the traversal stops at the first empty Option and an empty Option is returned.
+func TraverseOption[T, U any](list List[T], mapper func(T) control.Option[U]) control.Option[List[U]] {
+	values := make([]U, 0, list.Length())
+	for current := list.first; current != nil; current = current.next {
+		option := mapper(current.value)
+		if option.IsEmpty() {
+			return control.Empty[List[U]]()
+		}
+		values = append(values, option.OrElse(*new(U)))
+	}
+	return control.Of(fromSlice(values))
+}
+
+// SequenceOption turns a List[Option[T]] into an Option[List[T]].
+// an empty Option is returned if at least one element of the list is empty.
+func SequenceOption[T any](list List[control.Option[T]]) control.Option[List[T]] {
+	return TraverseOption(list, identity[control.Option[T]])
+}
+
+// TraverseTry maps each element of the List[T] to a Try[U] and collects the results in a Try[List[U]].
+// the traversal stops at the first failure and a failure with the same cause is returned.
+func TraverseTry[T, U any](list List[T], mapper func(T) control.Try[U]) control.Try[List[U]] {
+	values := make([]U, 0, list.Length())
+	for current := list.first; current != nil; current = current.next {
+		try := mapper(current.value)
+		value, cause := try.OrElseCause()
+		if try.IsFailure() {
+			return control.FailureOf[List[U]](cause)
+		}
+		values = append(values, value)
+	}
+	return control.SuccessOf(fromSlice(values))
+}
+
+// SequenceTry turns a List[Try[T]] into a Try[List[T]].
+// the first failure of the list is returned if there is any.
+func SequenceTry[T any](list List[control.Try[T]]) control.Try[List[T]] {
+	return TraverseTry(list, identity[control.Try[T]])
+}
+
+// TraverseEither maps each element of the List[T] to an Either[L, U] and collects the "right" values in an Either[L, List[U]].
+// the traversal stops at the first Left Either and a Left with the same "left" value is returned.
+func TraverseEither[L, T, U any](list List[T], mapper func(T) control.Either[L, U]) control.Either[L, List[U]] {
+	values := make([]U, 0, list.Length())
+	for current := list.first; current != nil; current = current.next {
+		either := mapper(current.value)
+		if either.IsLeft() {
+			return control.LeftOf[L, List[U]](either.GetLeftOrElse(*new(L)))
+		}
+		values = append(values, either.GetOrElse(*new(U)))
+	}
+	return control.RightOf[L](fromSlice(values))
+}
+
+// SequenceEither turns a List[Either[L, R]] into an Either[L, List[R]].
+// the first Left Either of the list is returned if there is any.
+func SequenceEither[L, R any](list List[control.Either[L, R]]) control.Either[L, List[R]] {
+	return TraverseEither(list, identity[control.Either[L, R]])
+}
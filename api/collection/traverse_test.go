@@ -0,0 +1,110 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"glours/go2funk/api/control"
+	"gotest.tools/v3/assert"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+var (
+	defaultTraverseError = errors.New("default traverse error")
+)
+
+func TestTraverseOption(t *testing.T) {
+	var mapper = func(value int) control.Option[string] {
+		return control.MapOption(control.Of(value).Filter(func(value int) bool { return value < 5 }), strconv.Itoa)
+	}
+	testCases := []struct {
+		name     string
+		value    List[int]
+		expected control.Option[List[string]]
+	}{
+		{
+			name:     "Empty List",
+			value:    emptyList,
+			expected: control.Of(Empty[string]()),
+		},
+		{
+			name:     "All elements defined",
+			value:    OfSlice([]int{1, 2, 3}),
+			expected: control.Of(OfSlice([]string{"1", "2", "3"})),
+		},
+		{
+			name:     "One element undefined",
+			value:    multipleElementsList,
+			expected: control.Empty[List[string]](),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := TraverseOption(testCase.value, mapper)
+			assert.Assert(t, reflect.DeepEqual(result, testCase.expected), fmt.Sprintf("expected %+v but value is %+v", testCase.expected, result))
+		})
+	}
+}
+
+func TestSequenceOption(t *testing.T) {
+	defined := OfSlice([]control.Option[int]{control.Of(1), control.Of(2)})
+	assert.Assert(t, reflect.DeepEqual(SequenceOption(defined), control.Of(OfSlice([]int{1, 2}))), "all options should be collected")
+
+	undefined := defined.Append(control.Empty[int]())
+	assert.Assert(t, SequenceOption(undefined).IsEmpty(), "result should be empty")
+}
+
+func TestTraverseTry(t *testing.T) {
+	calls := 0
+	var mapper = func(value int) control.Try[string] {
+		calls++
+		return control.TryOf(func() (string, error) {
+			if value%2 == 0 {
+				return "", fmt.Errorf("%w: %d", defaultTraverseError, value)
+			}
+			return strconv.Itoa(value), nil
+		})
+	}
+
+	result := TraverseTry(OfSlice([]int{1, 3, 5}), mapper)
+	assert.Assert(t, reflect.DeepEqual(result, control.SuccessOf(OfSlice([]string{"1", "3", "5"}))), "all values should be collected")
+
+	calls = 0
+	_, err := TraverseTry(multipleElementsList, mapper).OrElseCause()
+	assert.Error(t, err, "default traverse error: 2", "first failure should be returned")
+	assert.Equal(t, calls, 2, "traversal should stop at the first failure")
+}
+
+func TestSequenceTry(t *testing.T) {
+	successes := OfSlice([]control.Try[int]{control.SuccessOf(1), control.SuccessOf(2)})
+	assert.Assert(t, reflect.DeepEqual(SequenceTry(successes), control.SuccessOf(OfSlice([]int{1, 2}))), "all values should be collected")
+
+	withFailure := successes.Append(control.FailureOf[int](defaultTraverseError))
+	_, err := SequenceTry(withFailure).OrElseCause()
+	assert.Error(t, err, defaultTraverseError.Error(), "failure should be returned")
+}
+
+func TestTraverseEither(t *testing.T) {
+	var mapper = func(value int) control.Either[error, int] {
+		if value > 3 {
+			return control.LeftOf[error, int](fmt.Errorf("%d is too big", value))
+		}
+		return control.RightOf[error](value * 2)
+	}
+
+	result := TraverseEither(OfSlice([]int{1, 2, 3}), mapper)
+	assert.Assert(t, reflect.DeepEqual(result, control.RightOf[error](OfSlice([]int{2, 4, 6}))), "all values should be collected")
+
+	left := TraverseEither(multipleElementsList, mapper)
+	assert.Assert(t, left.IsLeft(), "result should be a Left")
+	assert.Error(t, left.GetLeftOrElse(nil), "4 is too big", "first left value should be returned")
+}
+
+func TestSequenceEither(t *testing.T) {
+	rights := OfSlice([]control.Either[string, int]{control.RightOf[string](1), control.RightOf[string](2)})
+	assert.Assert(t, reflect.DeepEqual(SequenceEither(rights), control.RightOf[string](OfSlice([]int{1, 2}))), "all values should be collected")
+
+	withLeft := rights.Append(control.LeftOf[string, int]("left")).Append(control.LeftOf[string, int]("other"))
+	assert.Equal(t, SequenceEither(withLeft).GetLeftOrElse(""), "left", "first left value should be returned")
+}
@@ -0,0 +1,106 @@
+package collection
+
+// View is a lazy view over the elements of a List.
+// Filter, Take, Drop, MapView and FlatMapView only record the operation, and all the recorded operations are fused
+// into a single pass over the source List when the View is forced with ToList, FoldView or ForEach.
+// the zero value of a View is an empty View.
+type View[T any] struct {
+	each func(yield func(T) bool)
+}
+
+// View returns a lazy View over the elements of the current list.
+func (l List[T]) View() View[T] {
+	return View[T]{l.ForEachWhile}
+}
+
+// MapView returns a View mapping the elements of the View[T] to elements of a new type U preserving their order.
+func MapView[T, U any](view View[T], mapper func(T) U) View[U] {
+	return View[U]{func(yield func(U) bool) {
+		view.iterate(func(value T) bool {
+			return yield(mapper(value))
+		})
+	}}
+}
+
+// FlatMapView returns a View mapping each element of the View[T] to a List[U] and concatenating the results preserving their order.
+func FlatMapView[T, U any](view View[T], mapper func(T) List[U]) View[U] {
+	return View[U]{func(yield func(U) bool) {
+		view.iterate(func(value T) bool {
+			for current := mapper(value).first; current != nil; current = current.next {
+				if !yield(current.value) {
+					return false
+				}
+			}
+			return true
+		})
+	}}
+}
+
+// FoldView forces the View[T] and combines its elements from left to right with the folder function, starting from zero.
+func FoldView[T, U any](view View[T], zero U, folder func(U, T) U) U {
+	result := zero
+	view.ForEach(func(value T) {
+		result = folder(result, value)
+	})
+	return result
+}
+
+// Filter returns a View containing only the elements which are validating the predicate passed as parameter.
+func (v View[T]) Filter(predicate func(T) bool) View[T] {
+	return View[T]{func(yield func(T) bool) {
+		v.iterate(func(value T) bool {
+			return !predicate(value) || yield(value)
+		})
+	}}
+}
+
+// Take returns a View containing at most the n first elements of the current View.
+// the source List isn't traversed further once the n elements are produced.
+func (v View[T]) Take(n int) View[T] {
+	return View[T]{func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		taken := 0
+		v.iterate(func(value T) bool {
+			taken++
+			return yield(value) && taken < n
+		})
+	}}
+}
+
+// Drop returns a View without the n first elements of the current View.
+func (v View[T]) Drop(n int) View[T] {
+	return View[T]{func(yield func(T) bool) {
+		dropped := 0
+		v.iterate(func(value T) bool {
+			if dropped < n {
+				dropped++
+				return true
+			}
+			return yield(value)
+		})
+	}}
+}
+
+// ForEach forces the View and calls the function passed as parameter on each of its elements.
+func (v View[T]) ForEach(f func(T)) {
+	v.iterate(func(value T) bool {
+		f(value)
+		return true
+	})
+}
+
+// ToList forces the View and returns a List containing its elements.
+func (v View[T]) ToList() List[T] {
+	var result appender[T]
+	v.ForEach(result.add)
+	return result.result(Empty[T]())
+}
+
+// iterate is an internal function pushing the elements of the View to yield until it returns false.
+func (v View[T]) iterate(yield func(T) bool) {
+	if v.each != nil {
+		v.each(yield)
+	}
+}
@@ -0,0 +1,126 @@
+package collection
+
+import (
+	"fmt"
+	"gotest.tools/v3/assert"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestView(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    View[int]
+		expected List[int]
+	}{
+		{name: "Zero value View", value: View[int]{}, expected: Empty[int]()},
+		{name: "View of empty List", value: emptyList.View(), expected: Empty[int]()},
+		{name: "View of List", value: multipleElementsList.View(), expected: multipleElementsList},
+		{name: "Filter", value: multipleElementsList.View().Filter(evenPredicate), expected: OfSlice([]int{2, 4})},
+		{name: "Take", value: multipleElementsList.View().Take(2), expected: OfSlice([]int{1, 2})},
+		{name: "Take more than length", value: multipleElementsList.View().Take(10), expected: multipleElementsList},
+		{name: "Take nothing", value: multipleElementsList.View().Take(0), expected: Empty[int]()},
+		{name: "Drop", value: multipleElementsList.View().Drop(3), expected: OfSlice([]int{4, 5})},
+		{name: "Drop more than length", value: multipleElementsList.View().Drop(10), expected: Empty[int]()},
+		{name: "Drop then Take", value: multipleElementsList.View().Drop(1).Take(3), expected: OfSlice([]int{2, 3, 4})},
+		{name: "Take then Filter", value: multipleElementsList.View().Take(3).Filter(evenPredicate), expected: Of(2)},
+		{
+			name:     "MapView",
+			value:    MapView(multipleElementsList.View(), func(value int) int { return value * 10 }),
+			expected: OfSlice([]int{10, 20, 30, 40, 50}),
+		},
+		{
+			name:     "FlatMapView then Take",
+			value:    FlatMapView(multipleElementsList.View(), func(value int) List[int] { return Fill(value, value) }).Take(4),
+			expected: OfSlice([]int{1, 2, 2, 3}),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := testCase.value.ToList()
+			assert.Assert(t, result.Equals(testCase.expected), fmt.Sprintf("expected %v but value is %v", testCase.expected, result))
+		})
+	}
+}
+
+func TestViewIsLazyAndFused(t *testing.T) {
+	var calls []string
+	view := MapView(multipleElementsList.View().Filter(func(value int) bool {
+		calls = append(calls, "filter "+strconv.Itoa(value))
+		return value%2 == 1
+	}), func(value int) string {
+		calls = append(calls, "map "+strconv.Itoa(value))
+		return strconv.Itoa(value)
+	}).Take(2)
+	assert.Equal(t, len(calls), 0, "nothing should be evaluated before forcing the View")
+
+	result := view.ToList()
+	assert.Assert(t, result.Equals(OfSlice([]string{"1", "3"})), fmt.Sprintf("unexpected value %v", result))
+	expectedCalls := OfSlice([]string{"filter 1", "map 1", "filter 2", "filter 3", "map 3"})
+	assert.Assert(t, OfSlice(calls).Equals(expectedCalls), fmt.Sprintf("expected %v but calls were %v", expectedCalls, calls))
+
+	calls = nil
+	view.ToList()
+	assert.Equal(t, len(calls), 5, "a View should be reusable")
+}
+
+func TestFoldViewAndForEach(t *testing.T) {
+	sum := FoldView(multipleElementsList.View().Filter(evenPredicate), 0, func(acc int, value int) int { return acc + value })
+	assert.Equal(t, sum, 6)
+
+	joined := FoldView(multipleElementsList.View(), "", func(acc string, value int) string { return acc + strconv.Itoa(value) })
+	assert.Equal(t, joined, "12345")
+
+	var visited []int
+	multipleElementsList.View().Drop(3).ForEach(func(value int) { visited = append(visited, value) })
+	assert.DeepEqual(t, visited, []int{4, 5})
+}
+
+var (
+	largeListOnce   sync.Once
+	cachedLargeList List[int]
+	viewPipeline    = func(value int) bool { return value%3 == 0 }
+	viewMapper      = func(value int) int { return value * 2 }
+)
+
+// largeList returns a List of 1M elements for the benchmarks, built once on first use.
+func largeList(b *testing.B) List[int] {
+	largeListOnce.Do(func() {
+		cachedLargeList = Tabulate(1000000, func(i int) int { return i })
+	})
+	b.ResetTimer()
+	return cachedLargeList
+}
+
+func BenchmarkEagerPipeline(b *testing.B) {
+	list := largeList(b)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		listSink = MapList(MapList(list.Filter(viewPipeline), viewMapper).Filter(evenPredicate), viewMapper)
+	}
+}
+
+func BenchmarkViewPipeline(b *testing.B) {
+	list := largeList(b)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		listSink = MapView(MapView(list.View().Filter(viewPipeline), viewMapper).Filter(evenPredicate), viewMapper).ToList()
+	}
+}
+
+func BenchmarkEagerFold(b *testing.B) {
+	list := largeList(b)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		intSink = Sum(MapList(list.Filter(viewPipeline), viewMapper))
+	}
+}
+
+func BenchmarkViewFold(b *testing.B) {
+	list := largeList(b)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		intSink = FoldView(MapView(list.View().Filter(viewPipeline), viewMapper), 0, func(acc int, value int) int { return acc + value })
+	}
+}
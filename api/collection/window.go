@@ -0,0 +1,84 @@
+package collection
+
+import (
+	"fmt"
+
+	"glours/go2funk/api"
+)
+
+// Sliding groups the elements of the List[T] in windows of the given size, each window starting step elements after the previous one.
+// the last window may contain fewer elements than size if the list does not divide evenly.
+// this function returns error if size or step are less than or equal to 0.
+func Sliding[T any](list List[T], size int, step int) (List[List[T]], error) {
+	if size <= 0 {
+		return Empty[List[T]](), fmt.Errorf("invalid window size %d on List", size)
+	}
+	if step <= 0 {
+		return Empty[List[T]](), fmt.Errorf("invalid window step %d on List", step)
+	}
+	elements := toSlice(list)
+	var windows []List[T]
+	for start := 0; start < len(elements); start += step {
+		end := start + size
+		if end > len(elements) {
+			end = len(elements)
+		}
+		windows = append(windows, fromSlice(elements[start:end]))
+		if end == len(elements) {
+			break
+		}
+	}
+	return fromSlice(windows), nil
+}
+
+// Grouped splits the elements of the List[T] in consecutive groups of the given size.
+// the last group may contain fewer elements than size if the list does not divide evenly.
+// this function returns error if size is less than or equal to 0.
+func Grouped[T any](list List[T], size int) (List[List[T]], error) {
+	return Sliding(list, size, size)
+}
+
+// SplitWhen splits the List[T] in chunks, starting a new chunk between two neighbours validating the predicate passed as parameter.
+// the predicate receives the previous element and the next one.
+func SplitWhen[T any](list List[T], predicate func(previous T, next T) bool) List[List[T]] {
+	if list.IsEmpty() {
+		return Empty[List[T]]()
+	}
+	var chunks []List[T]
+	chunk := []T{list.head()}
+	previous := list.head()
+	for current := list.first.next; current != nil; current = current.next {
+		if predicate(previous, current.value) {
+			chunks = append(chunks, fromSlice(chunk))
+			chunk = nil
+		}
+		chunk = append(chunk, current.value)
+		previous = current.value
+	}
+	chunks = append(chunks, fromSlice(chunk))
+	return fromSlice(chunks)
+}
+
+// Pairwise returns a List of Pair containing each element of the List[T] with its next neighbour.
+// lists with less than two elements return an empty List.
+func Pairwise[T any](list List[T]) List[api.Pair[T, T]] {
+	if list.IsEmpty() {
+		return Empty[api.Pair[T, T]]()
+	}
+	pairs := make([]api.Pair[T, T], 0, list.Length()-1)
+	previous := list.head()
+	for current := list.first.next; current != nil; current = current.next {
+		pairs = append(pairs, api.NewPair(previous, current.value))
+		previous = current.value
+	}
+	return fromSlice(pairs)
+}
+
+// toSlice is an internal function collecting the elements of a List in a slice preserving their order.
+func toSlice[T any](list List[T]) []T {
+	elements := make([]T, 0, list.Length())
+	for current := list.first; current != nil; current = current.next {
+		elements = append(elements, current.value)
+	}
+	return elements
+}
@@ -0,0 +1,161 @@
+package collection
+
+import (
+	"fmt"
+	"glours/go2funk/api"
+	"gotest.tools/v3/assert"
+	"testing"
+)
+
+func TestSliding(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    List[int]
+		size     int
+		step     int
+		expected List[List[int]]
+	}{
+		{
+			name:     "Empty List",
+			value:    emptyList,
+			size:     2,
+			step:     1,
+			expected: Empty[List[int]](),
+		},
+		{
+			name:     "List shorter than size",
+			value:    singleElementList,
+			size:     2,
+			step:     1,
+			expected: Of(Of(10)),
+		},
+		{
+			name:     "Overlapping windows",
+			value:    multipleElementsList,
+			size:     3,
+			step:     1,
+			expected: OfSlice([]List[int]{OfSlice([]int{1, 2, 3}), OfSlice([]int{2, 3, 4}), OfSlice([]int{3, 4, 5})}),
+		},
+		{
+			name:     "Partial last window",
+			value:    multipleElementsList,
+			size:     3,
+			step:     2,
+			expected: OfSlice([]List[int]{OfSlice([]int{1, 2, 3}), OfSlice([]int{3, 4, 5})}),
+		},
+		{
+			name:     "Step greater than size",
+			value:    multipleElementsList,
+			size:     1,
+			step:     3,
+			expected: OfSlice([]List[int]{Of(1), Of(4)}),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := Sliding(testCase.value, testCase.size, testCase.step)
+			assert.NilError(t, err)
+			assert.Assert(t, result.Equals(testCase.expected), fmt.Sprintf("expected %+v but value is %+v", testCase.expected, result))
+		})
+	}
+}
+
+func TestSlidingInvalidParameters(t *testing.T) {
+	_, err := Sliding(multipleElementsList, 0, 1)
+	assert.Error(t, err, "invalid window size 0 on List")
+
+	_, err = Sliding(multipleElementsList, 2, -1)
+	assert.Error(t, err, "invalid window step -1 on List")
+}
+
+func TestGrouped(t *testing.T) {
+	result, err := Grouped(multipleElementsList, 2)
+	assert.NilError(t, err)
+	expected := OfSlice([]List[int]{OfSlice([]int{1, 2}), OfSlice([]int{3, 4}), Of(5)})
+	assert.Assert(t, result.Equals(expected), fmt.Sprintf("expected %+v but value is %+v", expected, result))
+
+	_, err = Grouped(multipleElementsList, 0)
+	assert.Error(t, err, "invalid window size 0 on List")
+}
+
+func TestSplitWhen(t *testing.T) {
+	var gapPredicate = func(previous int, next int) bool {
+		return next-previous > 1
+	}
+	testCases := []struct {
+		name     string
+		value    List[int]
+		expected List[List[int]]
+	}{
+		{
+			name:     "Empty List",
+			value:    emptyList,
+			expected: Empty[List[int]](),
+		},
+		{
+			name:     "Single Element List",
+			value:    singleElementList,
+			expected: Of(Of(10)),
+		},
+		{
+			name:     "Multiple Elements List without split",
+			value:    multipleElementsList,
+			expected: Of(multipleElementsList),
+		},
+		{
+			name:     "Multiple Elements List with splits",
+			value:    OfSlice([]int{1, 2, 5, 6, 7, 10}),
+			expected: OfSlice([]List[int]{OfSlice([]int{1, 2}), OfSlice([]int{5, 6, 7}), Of(10)}),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := SplitWhen(testCase.value, gapPredicate)
+			assert.Assert(t, result.Equals(testCase.expected), fmt.Sprintf("expected %+v but value is %+v", testCase.expected, result))
+		})
+	}
+}
+
+func TestPairwise(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    List[int]
+		expected List[api.Pair[int, int]]
+	}{
+		{
+			name:     "Empty List",
+			value:    emptyList,
+			expected: Empty[api.Pair[int, int]](),
+		},
+		{
+			name:     "Single Element List",
+			value:    singleElementList,
+			expected: Empty[api.Pair[int, int]](),
+		},
+		{
+			name:     "Multiple Elements List",
+			value:    OfSlice([]int{1, 2, 3}),
+			expected: OfSlice([]api.Pair[int, int]{api.NewPair(1, 2), api.NewPair(2, 3)}),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := Pairwise(testCase.value)
+			assert.Assert(t, result.Equals(testCase.expected), fmt.Sprintf("expected %+v but value is %+v", testCase.expected, result))
+		})
+	}
+}
+
+func TestWindowsOnLargeList(t *testing.T) {
+	elements := make([]int, 100000)
+	for i := range elements {
+		elements[i] = i
+	}
+	list := fromSlice(elements)
+
+	grouped, err := Grouped(list, 10)
+	assert.NilError(t, err)
+	assert.Equal(t, grouped.Length(), 10000)
+	assert.Equal(t, Pairwise(list).Length(), 99999)
+	assert.Equal(t, list.Intersperse(-1).Length(), 199999)
+}
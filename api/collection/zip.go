@@ -0,0 +1,27 @@
+package collection
+
+import "glours/go2funk/api"
+
+// Zip3 returns a List of Tuple3 combining the elements of the three lists at the same position.
+// the length of the result is the length of the shortest list.
+func Zip3[A, B, C any](first List[A], second List[B], third List[C]) List[api.Tuple3[A, B, C]] {
+	var tuples []api.Tuple3[A, B, C]
+	for !first.IsEmpty() && !second.IsEmpty() && !third.IsEmpty() {
+		tuples = append(tuples, api.NewTuple3(first.head(), second.head(), third.head()))
+		first, second, third = first.tail(), second.tail(), third.tail()
+	}
+	return fromSlice(tuples)
+}
+
+// Unzip3 splits a List of Tuple3 into a Tuple3 of three lists containing the values of each position.
+func Unzip3[A, B, C any](list List[api.Tuple3[A, B, C]]) api.Tuple3[List[A], List[B], List[C]] {
+	first := make([]A, 0, list.Length())
+	second := make([]B, 0, list.Length())
+	third := make([]C, 0, list.Length())
+	for current := list.first; current != nil; current = current.next {
+		first = append(first, current.value.Get1())
+		second = append(second, current.value.Get2())
+		third = append(third, current.value.Get3())
+	}
+	return api.NewTuple3(fromSlice(first), fromSlice(second), fromSlice(third))
+}
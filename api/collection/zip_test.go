@@ -0,0 +1,57 @@
+package collection
+
+import (
+	"fmt"
+	"glours/go2funk/api"
+	"gotest.tools/v3/assert"
+	"testing"
+)
+
+func TestZip3(t *testing.T) {
+	testCases := []struct {
+		name     string
+		first    List[int]
+		second   List[string]
+		third    List[bool]
+		expected List[api.Tuple3[int, string, bool]]
+	}{
+		{
+			name:     "Empty Lists",
+			first:    emptyList,
+			second:   Empty[string](),
+			third:    Empty[bool](),
+			expected: Empty[api.Tuple3[int, string, bool]](),
+		},
+		{
+			name:     "Lists of same length",
+			first:    OfSlice([]int{1, 2}),
+			second:   OfSlice([]string{"one", "two"}),
+			third:    OfSlice([]bool{false, true}),
+			expected: OfSlice([]api.Tuple3[int, string, bool]{api.NewTuple3(1, "one", false), api.NewTuple3(2, "two", true)}),
+		},
+		{
+			name:     "Lists of different length",
+			first:    multipleElementsList,
+			second:   OfSlice([]string{"one", "two"}),
+			third:    Of(true),
+			expected: Of(api.NewTuple3(1, "one", true)),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := Zip3(testCase.first, testCase.second, testCase.third)
+			assert.Assert(t, result.Equals(testCase.expected), fmt.Sprintf("expected %+v but value is %+v", testCase.expected, result))
+		})
+	}
+}
+
+func TestUnzip3(t *testing.T) {
+	zipped := Zip3(OfSlice([]int{1, 2}), OfSlice([]string{"one", "two"}), OfSlice([]bool{false, true}))
+	result := Unzip3(zipped)
+	assert.Assert(t, result.Get1().Equals(OfSlice([]int{1, 2})))
+	assert.Assert(t, result.Get2().Equals(OfSlice([]string{"one", "two"})))
+	assert.Assert(t, result.Get3().Equals(OfSlice([]bool{false, true})))
+
+	empty := Unzip3(Empty[api.Tuple3[int, string, bool]]())
+	assert.Assert(t, empty.Get1().IsEmpty() && empty.Get2().IsEmpty() && empty.Get3().IsEmpty())
+}
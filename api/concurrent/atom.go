@@ -0,0 +1,152 @@
+package concurrent
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	"glours/go2funk/api/control"
+)
+
+// Atom is a reference to a value shared between goroutines, meant to hold the current version of a persistent structure.
+// updates are atomic, validated before being applied and notified to the watchers once applied.
+// the zero value of an Atom holds the zero value of T, without validator.
+type Atom[T any] struct {
+	current   atomic.Pointer[atomState[T]]
+	validator func(T) error
+	lock      sync.Mutex
+	watchers  map[string]func(old, new T)
+}
+
+// atomState is the internal box of the value of an Atom, each update installs a new box with compare-and-swap.
+type atomState[T any] struct {
+	value T
+}
+
+// AtomOption configures the behaviour of an Atom.
+type AtomOption[T any] func(*Atom[T])
+
+// WithValidator sets the function validating each new state of an Atom, an update is rejected with the error it returns.
+func WithValidator[T any](validator func(T) error) AtomOption[T] {
+	return func(atom *Atom[T]) {
+		atom.validator = validator
+	}
+}
+
+// NewAtom returns a new Atom holding the initial value passed as parameter.
+// this function returns error if the initial value is rejected by the validator.
+func NewAtom[T any](initial T, options ...AtomOption[T]) (*Atom[T], error) {
+	atom := &Atom[T]{}
+	for _, option := range options {
+		option(atom)
+	}
+	if err := atom.validate(initial); err != nil {
+		return nil, err
+	}
+	atom.current.Store(&atomState[T]{value: initial})
+	return atom, nil
+}
+
+// Load returns the current value of the Atom.
+func (a *Atom[T]) Load() T {
+	return a.current.Load().get()
+}
+
+// Swap atomically replaces the value of the Atom with the result of the update function applied to the current value.
+// the function is applied again to the new current value if another goroutine updated the Atom in the meantime, so it
+// should be free of side effects.
+// it returns a successful Try with the new value, or a failure with the error of the validator rejecting it.
+func (a *Atom[T]) Swap(update func(T) T) control.Try[T] {
+	for {
+		current := a.current.Load()
+		value := update(current.get())
+		if err := a.validate(value); err != nil {
+			return control.FailureOf[T](err)
+		}
+		if a.compareAndSwap(current, value) {
+			return control.SuccessOf(value)
+		}
+	}
+}
+
+// CompareAndSet atomically replaces the value of the Atom with the value passed as parameter if the current value
+// matches the expected one, compared with reflect.DeepEqual.
+// it returns false if the current value doesn't match, and an error if the validator rejects the new value.
+func (a *Atom[T]) CompareAndSet(expected T, value T) (bool, error) {
+	if err := a.validate(value); err != nil {
+		return false, err
+	}
+	for {
+		current := a.current.Load()
+		if !reflect.DeepEqual(current.get(), expected) {
+			return false, nil
+		}
+		if a.compareAndSwap(current, value) {
+			return true, nil
+		}
+	}
+}
+
+// Reset replaces the value of the Atom with the value passed as parameter regardless of the current value.
+// this function returns error if the validator rejects the new value.
+func (a *Atom[T]) Reset(value T) error {
+	_, err := a.Swap(func(T) T { return value }).OrElseCause()
+	return err
+}
+
+// AddWatcher registers the watcher under the key passed as parameter, replacing any watcher with the same key.
+// a watcher is called with the old and the new value after each successful update, by the goroutine which applied it.
+func (a *Atom[T]) AddWatcher(key string, watcher func(old, new T)) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if a.watchers == nil {
+		a.watchers = map[string]func(old, new T){}
+	}
+	a.watchers[key] = watcher
+}
+
+// RemoveWatcher unregisters the watcher registered under the key passed as parameter, if any.
+func (a *Atom[T]) RemoveWatcher(key string) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	delete(a.watchers, key)
+}
+
+// get is an internal function returning the value of the state, the zero value of T for the nil state of a zero Atom.
+func (s *atomState[T]) get() T {
+	if s == nil {
+		return *new(T)
+	}
+	return s.value
+}
+
+// validate is an internal function checking the value with the validator of the Atom, if any.
+func (a *Atom[T]) validate(value T) error {
+	if a.validator == nil {
+		return nil
+	}
+	return a.validator(value)
+}
+
+// compareAndSwap is an internal function installing the value if the state of the Atom is still the current one,
+// and notifying the watchers if it's the case.
+func (a *Atom[T]) compareAndSwap(current *atomState[T], value T) bool {
+	if !a.current.CompareAndSwap(current, &atomState[T]{value: value}) {
+		return false
+	}
+	a.notify(current.get(), value)
+	return true
+}
+
+// notify is an internal function calling the watchers registered when the update was applied.
+func (a *Atom[T]) notify(old, new T) {
+	a.lock.Lock()
+	watchers := make([]func(old, new T), 0, len(a.watchers))
+	for _, watcher := range a.watchers {
+		watchers = append(watchers, watcher)
+	}
+	a.lock.Unlock()
+	for _, watcher := range watchers {
+		watcher(old, new)
+	}
+}
@@ -0,0 +1,143 @@
+package concurrent
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"glours/go2funk/api/collection"
+	"gotest.tools/v3/assert"
+)
+
+var errNegative = errors.New("negative value")
+
+func positive(value int) error {
+	if value < 0 {
+		return errNegative
+	}
+	return nil
+}
+
+func TestZeroValueAtom(t *testing.T) {
+	var atom Atom[int]
+	assert.Equal(t, atom.Load(), 0)
+	swapped, err := atom.CompareAndSet(0, 5)
+	assert.NilError(t, err)
+	assert.Assert(t, swapped)
+	value, err := atom.Swap(func(value int) int { return value + 1 }).OrElseCause()
+	assert.NilError(t, err)
+	assert.Equal(t, value, 6)
+}
+
+func TestNewAtom(t *testing.T) {
+	atom, err := NewAtom(10, WithValidator(positive))
+	assert.NilError(t, err)
+	assert.Equal(t, atom.Load(), 10)
+
+	_, err = NewAtom(-1, WithValidator(positive))
+	assert.Equal(t, err, errNegative)
+}
+
+func TestAtomUpdates(t *testing.T) {
+	testCases := []struct {
+		name     string
+		update   func(*Atom[int]) error
+		expected int
+		err      error
+	}{
+		{
+			name: "Swap",
+			update: func(atom *Atom[int]) error {
+				_, err := atom.Swap(func(value int) int { return value * 2 }).OrElseCause()
+				return err
+			},
+			expected: 20,
+		},
+		{
+			name: "Swap rejected by validator",
+			update: func(atom *Atom[int]) error {
+				_, err := atom.Swap(func(value int) int { return -value }).OrElseCause()
+				return err
+			},
+			expected: 10,
+			err:      errNegative,
+		},
+		{
+			name:     "CompareAndSet matching",
+			update:   func(atom *Atom[int]) error { return expectSwapped(atom.CompareAndSet(10, 11)) },
+			expected: 11,
+		},
+		{
+			name:     "CompareAndSet not matching",
+			update:   func(atom *Atom[int]) error { return expectSwapped(atom.CompareAndSet(5, 11)) },
+			expected: 10,
+			err:      errNotSwapped,
+		},
+		{
+			name:     "CompareAndSet rejected by validator",
+			update:   func(atom *Atom[int]) error { return expectSwapped(atom.CompareAndSet(10, -1)) },
+			expected: 10,
+			err:      errNegative,
+		},
+		{name: "Reset", update: func(atom *Atom[int]) error { return atom.Reset(3) }, expected: 3},
+		{name: "Reset rejected by validator", update: func(atom *Atom[int]) error { return atom.Reset(-3) }, expected: 10, err: errNegative},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			atom, err := NewAtom(10, WithValidator(positive))
+			assert.NilError(t, err)
+			err = testCase.update(atom)
+			assert.Equal(t, err, testCase.err)
+			assert.Equal(t, atom.Load(), testCase.expected)
+		})
+	}
+}
+
+var errNotSwapped = errors.New("not swapped")
+
+func expectSwapped(swapped bool, err error) error {
+	if err == nil && !swapped {
+		return errNotSwapped
+	}
+	return err
+}
+
+func TestAtomWatchers(t *testing.T) {
+	atom, err := NewAtom(collection.Empty[string]())
+	assert.NilError(t, err)
+	var changes []string
+	atom.AddWatcher("log", func(old, new collection.List[string]) {
+		changes = append(changes, old.String()+" -> "+new.String())
+	})
+	atom.Swap(func(routes collection.List[string]) collection.List[string] { return routes.Append("/users") })
+	atom.Reset(collection.Of("/orders"))
+	atom.RemoveWatcher("log")
+	atom.Reset(collection.Empty[string]())
+	assert.DeepEqual(t, changes, []string{"List() -> List(/users)", "List(/users) -> List(/orders)"})
+}
+
+func TestAtomConcurrentSwaps(t *testing.T) {
+	atom, err := NewAtom(collection.Empty[int]())
+	assert.NilError(t, err)
+	var notifications atomic.Int32
+	atom.AddWatcher("count", func(old, new collection.List[int]) {
+		assert.Equal(t, new.Length(), old.Length()+1)
+		notifications.Add(1)
+	})
+	var group sync.WaitGroup
+	for worker := 0; worker < 8; worker++ {
+		group.Add(1)
+		go func(worker int) {
+			defer group.Done()
+			for i := 0; i < 100; i++ {
+				atom.Swap(func(list collection.List[int]) collection.List[int] {
+					return collection.Concat(collection.Of(worker), list)
+				})
+			}
+		}(worker)
+	}
+	group.Wait()
+	assert.Equal(t, atom.Load().Length(), 800, "no update should be lost")
+	assert.Equal(t, notifications.Load(), int32(800))
+}
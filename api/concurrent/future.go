@@ -0,0 +1,247 @@
+package concurrent
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	"glours/go2funk/api/collection"
+	"glours/go2funk/api/control"
+)
+
+// ErrNoFuture is the cause of the Future returned by Any or Race for an empty List of Futures.
+var ErrNoFuture = errors.New("no Future to complete with")
+
+// Future is a read-only handle on a Try[A] computed asynchronously.
+// a Future can be copied and awaited by several goroutines, it is completed once and its result never changes.
+// the zero value of a Future is completed with a failed Try without cause, like the zero value of Try.
+type Future[A any] struct {
+	state *futureState[A]
+}
+
+// closed is the done channel of the zero value of Future.
+var closed = func() chan struct{} {
+	channel := make(chan struct{})
+	close(channel)
+	return channel
+}()
+
+// Async returns a Future completed with the result of the lambda passed as parameter, executed in a new goroutine.
+// a panic in the lambda fails the Future with a PanicError cause.
+// the Future fails with the cause of the context as soon as it's done, without waiting for the lambda which is
+// not executed at all if the context is already done.
+func Async[A any](ctx context.Context, lambda func() (A, error)) Future[A] {
+	promise := NewPromise[A]()
+	if ctx.Err() != nil {
+		promise.Failure(context.Cause(ctx))
+		return promise.Future()
+	}
+	go func() {
+		promise.Complete(protect(lambda))
+	}()
+	if ctx.Done() != nil {
+		go func() {
+			select {
+			case <-ctx.Done():
+				promise.Failure(context.Cause(ctx))
+			case <-promise.Future().Done():
+			}
+		}()
+	}
+	return promise.Future()
+}
+
+// SuccessfulFuture returns a Future already completed with a successful Try containing the value passed as parameter.
+func SuccessfulFuture[A any](value A) Future[A] {
+	return completedFuture(control.SuccessOf(value))
+}
+
+// FailedFuture returns a Future already completed with a failed Try with the cause passed as parameter.
+func FailedFuture[A any](cause error) Future[A] {
+	return completedFuture(control.FailureOf[A](cause))
+}
+
+// completedFuture is an internal function returning a Future already completed with the Try passed as parameter.
+func completedFuture[A any](result control.Try[A]) Future[A] {
+	promise := NewPromise[A]()
+	promise.Complete(result)
+	return promise.Future()
+}
+
+// MapFuture returns a Future mapping the successful value of the Future[A] to a value of type B once it's completed.
+// a failure is propagated unchanged.
+func MapFuture[A, B any](future Future[A], mapper func(A) B) Future[B] {
+	return onComplete(future, func(result control.Try[A]) Future[B] {
+		return completedFuture(control.MapTry(result, mapper))
+	})
+}
+
+// FlatMapFuture returns a Future completed like the Future[B] returned by the mapper for the successful value of the Future[A].
+// a failure is propagated unchanged.
+func FlatMapFuture[A, B any](future Future[A], mapper func(A) Future[B]) Future[B] {
+	return onComplete(future, func(result control.Try[A]) Future[B] {
+		value, err := result.OrElseCause()
+		if err != nil {
+			return FailedFuture[B](err)
+		}
+		return mapper(value)
+	})
+}
+
+// onComplete is an internal function returning a Future completed like the one returned by next for the result of the future.
+// a panic in next fails the returned Future with a PanicError cause.
+func onComplete[A, B any](future Future[A], next func(control.Try[A]) Future[B]) Future[B] {
+	promise := NewPromise[B]()
+	go func() {
+		<-future.Done()
+		result, err := protect(func() (Future[B], error) {
+			return next(future.result()), nil
+		}).OrElseCause()
+		if err != nil {
+			promise.Failure(err)
+			return
+		}
+		<-result.Done()
+		promise.Complete(result.result())
+	}()
+	return promise.Future()
+}
+
+// Done returns a channel closed once the current Future is completed.
+func (f Future[A]) Done() <-chan struct{} {
+	if f.state == nil {
+		return closed
+	}
+	return f.state.done
+}
+
+// IsCompleted checks if the current Future is completed or not, without blocking.
+func (f Future[A]) IsCompleted() bool {
+	select {
+	case <-f.Done():
+		return true
+	default:
+		return false
+	}
+}
+
+// Value returns an Option containing the result of the current Future if it's completed, without blocking.
+func (f Future[A]) Value() control.Option[control.Try[A]] {
+	if !f.IsCompleted() {
+		return control.Empty[control.Try[A]]()
+	}
+	return control.Of(f.result())
+}
+
+// Await waits for the completion of the current Future and returns its result.
+// a failed Try with the cause of the context is returned if the context is done before, the Future itself isn't affected.
+func (f Future[A]) Await(ctx context.Context) control.Try[A] {
+	select {
+	case <-f.Done():
+		return f.result()
+	case <-ctx.Done():
+		return control.FailureOf[A](context.Cause(ctx))
+	}
+}
+
+// result is an internal function returning the result of a completed Future.
+func (f Future[A]) result() control.Try[A] {
+	if f.state == nil {
+		return control.Try[A]{}
+	}
+	return f.state.result
+}
+
+// All returns a Future completed with the results of all the Futures passed as parameter, in the same order,
+// once all of them are completed.
+func All[A any](futures collection.List[Future[A]]) Future[collection.List[control.Try[A]]] {
+	promise := NewPromise[collection.List[control.Try[A]]]()
+	results := make([]control.Try[A], futures.Length())
+	watch(futures, func(index int, result control.Try[A]) {
+		results[index] = result
+	}, func() {
+		promise.Success(collection.OfSlice(results))
+	})
+	return promise.Future()
+}
+
+// Sequence returns a Future completed with the values of all the Futures passed as parameter, in the same order,
+// once all of them are successful.
+// it fails with the cause of the first Future failing, without waiting for the other ones.
+func Sequence[A any](futures collection.List[Future[A]]) Future[collection.List[A]] {
+	promise := NewPromise[collection.List[A]]()
+	values := make([]A, futures.Length())
+	watch(futures, func(index int, result control.Try[A]) {
+		value, err := result.OrElseCause()
+		if err != nil {
+			promise.Failure(err)
+			return
+		}
+		values[index] = value
+	}, func() {
+		promise.Success(collection.OfSlice(values))
+	})
+	return promise.Future()
+}
+
+// Any returns a Future completed with the value of the first successful Future passed as parameter.
+// it fails with all the causes joined in order if all the Futures fail, or with ErrNoFuture if the List is empty.
+func Any[A any](futures collection.List[Future[A]]) Future[A] {
+	if futures.IsEmpty() {
+		return FailedFuture[A](ErrNoFuture)
+	}
+	promise := NewPromise[A]()
+	causes := make([]error, futures.Length())
+	watch(futures, func(index int, result control.Try[A]) {
+		value, err := result.OrElseCause()
+		if err != nil {
+			causes[index] = err
+			return
+		}
+		promise.Success(value)
+	}, func() {
+		promise.Failure(errors.Join(causes...))
+	})
+	return promise.Future()
+}
+
+// Race returns a Future completed like the first completed Future passed as parameter, successful or not.
+// it fails with ErrNoFuture if the List is empty.
+func Race[A any](futures collection.List[Future[A]]) Future[A] {
+	if futures.IsEmpty() {
+		return FailedFuture[A](ErrNoFuture)
+	}
+	promise := NewPromise[A]()
+	watch(futures, func(_ int, result control.Try[A]) {
+		promise.Complete(result)
+	}, func() {})
+	return promise.Future()
+}
+
+// watch is an internal function calling onResult with the index and the result of each Future once it's completed,
+// and onAll once all of them are completed.
+// calls to onResult are serialized, and onAll is called after the last one, immediately for an empty List.
+func watch[A any](futures collection.List[Future[A]], onResult func(int, control.Try[A]), onAll func()) {
+	var lock sync.Mutex
+	var remaining atomic.Int64
+	remaining.Store(int64(futures.Length()))
+	if futures.IsEmpty() {
+		onAll()
+		return
+	}
+	index := 0
+	futures.ForEachWhile(func(future Future[A]) bool {
+		go func(index int, future Future[A]) {
+			<-future.Done()
+			lock.Lock()
+			onResult(index, future.result())
+			lock.Unlock()
+			if remaining.Add(-1) == 0 {
+				onAll()
+			}
+		}(index, future)
+		index++
+		return true
+	})
+}
@@ -0,0 +1,237 @@
+package concurrent
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"glours/go2funk/api/collection"
+	"glours/go2funk/api/control"
+	"gotest.tools/v3/assert"
+)
+
+var errOther = errors.New("other")
+
+// pending returns a Future which isn't completed before the returned function is called.
+func pending[A any]() (Future[A], func(control.Try[A])) {
+	promise := NewPromise[A]()
+	return promise.Future(), func(result control.Try[A]) { promise.Complete(result) }
+}
+
+func await[A any](t *testing.T, future Future[A]) control.Try[A] {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	result := future.Await(ctx)
+	_, err := result.OrElseCause()
+	assert.Assert(t, !errors.Is(err, context.DeadlineExceeded), "Future not completed in time")
+	return result
+}
+
+func TestZeroValueFuture(t *testing.T) {
+	var future Future[int]
+	assert.Assert(t, future.IsCompleted())
+	_, err := future.Await(context.Background()).OrElseCause()
+	assert.Assert(t, errors.Is(err, control.ErrEmptyTry))
+}
+
+func TestAsync(t *testing.T) {
+	testCases := []struct {
+		name     string
+		lambda   func() (int, error)
+		expected control.Try[int]
+	}{
+		{name: "Success", lambda: func() (int, error) { return 10, nil }, expected: control.SuccessOf(10)},
+		{name: "Failure", lambda: func() (int, error) { return 0, errBoom }, expected: control.FailureOf[int](errBoom)},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, await(t, Async(context.Background(), testCase.lambda)), testCase.expected)
+		})
+	}
+}
+
+func TestAsyncWithDoneContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	called := false
+	result := await(t, Async(ctx, func() (int, error) {
+		called = true
+		return 10, nil
+	}))
+	assert.Equal(t, result, control.FailureOf[int](context.Canceled))
+	assert.Assert(t, !called, "the lambda should not be executed with a done context")
+}
+
+func TestAsyncCancelledWhileRunning(t *testing.T) {
+	cause := errors.New("shutting down")
+	ctx, cancel := context.WithCancelCause(context.Background())
+	started, release := make(chan struct{}), make(chan struct{})
+	defer close(release)
+	future := Async(ctx, func() (int, error) {
+		close(started)
+		<-release
+		return 10, nil
+	})
+	<-started
+	cancel(cause)
+	assert.Equal(t, await(t, future), control.FailureOf[int](cause))
+
+	ctx, cancelTimeout := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancelTimeout()
+	future = Async(ctx, func() (int, error) {
+		<-release
+		return 10, nil
+	})
+	assert.Equal(t, future.Await(context.Background()), control.FailureOf[int](context.DeadlineExceeded))
+}
+
+func TestAwaitHonoursContext(t *testing.T) {
+	future, complete := pending[int]()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, future.Await(ctx), control.FailureOf[int](context.Canceled))
+
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, future.Await(ctx), control.FailureOf[int](context.DeadlineExceeded))
+
+	assert.Assert(t, !future.IsCompleted(), "awaiting should not complete the Future")
+	complete(control.SuccessOf(1))
+	assert.Equal(t, await(t, future), control.SuccessOf(1))
+}
+
+func TestMapAndFlatMapFuture(t *testing.T) {
+	testCases := []struct {
+		name     string
+		future   Future[string]
+		expected control.Try[string]
+	}{
+		{name: "MapFuture success", future: MapFuture(SuccessfulFuture(10), strconv.Itoa), expected: control.SuccessOf("10")},
+		{name: "MapFuture failure", future: MapFuture(FailedFuture[int](errBoom), strconv.Itoa), expected: control.FailureOf[string](errBoom)},
+		{
+			name: "FlatMapFuture success",
+			future: FlatMapFuture(SuccessfulFuture(10), func(value int) Future[string] {
+				return Async(context.Background(), func() (string, error) { return strconv.Itoa(value * 2), nil })
+			}),
+			expected: control.SuccessOf("20"),
+		},
+		{
+			name:     "FlatMapFuture inner failure",
+			future:   FlatMapFuture(SuccessfulFuture(10), func(int) Future[string] { return FailedFuture[string](errOther) }),
+			expected: control.FailureOf[string](errOther),
+		},
+		{
+			name:     "FlatMapFuture outer failure",
+			future:   FlatMapFuture(FailedFuture[int](errBoom), func(int) Future[string] { return SuccessfulFuture("unused") }),
+			expected: control.FailureOf[string](errBoom),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, await(t, testCase.future), testCase.expected)
+		})
+	}
+}
+
+func TestFuturePanicsAreFailures(t *testing.T) {
+	testCases := []struct {
+		name   string
+		future Future[int]
+	}{
+		{
+			name:   "Async",
+			future: Async(context.Background(), func() (int, error) { panic(errBoom) }),
+		},
+		{
+			name:   "MapFuture",
+			future: MapFuture(SuccessfulFuture(1), func(int) int { panic(errBoom) }),
+		},
+		{
+			name:   "FlatMapFuture",
+			future: FlatMapFuture(SuccessfulFuture(1), func(int) Future[int] { panic(errBoom) }),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := await(t, testCase.future).OrElseCause()
+			var panicError *PanicError
+			assert.Assert(t, errors.As(err, &panicError), "expected a PanicError but cause is %v", err)
+			assert.Assert(t, errors.Is(err, errBoom))
+		})
+	}
+}
+
+func TestAwaitReturnsContextCause(t *testing.T) {
+	future, _ := pending[int]()
+	cause := errors.New("shutting down")
+	ctx, cancel := context.WithCancelCause(context.Background())
+	cancel(cause)
+	assert.Equal(t, future.Await(ctx), control.FailureOf[int](cause))
+}
+
+func TestAll(t *testing.T) {
+	result := await(t, All(collection.OfSlice([]Future[int]{SuccessfulFuture(1), FailedFuture[int](errBoom), SuccessfulFuture(3)})))
+	list, err := result.OrElseCause()
+	assert.NilError(t, err)
+	expected := collection.OfSlice([]control.Try[int]{control.SuccessOf(1), control.FailureOf[int](errBoom), control.SuccessOf(3)})
+	assert.Assert(t, list.Equals(expected), "unexpected value %v", list)
+
+	empty, err := await(t, All(collection.Empty[Future[int]]())).OrElseCause()
+	assert.NilError(t, err)
+	assert.Assert(t, empty.IsEmpty())
+}
+
+func TestSequence(t *testing.T) {
+	first, completeFirst := pending[int]()
+	second, completeSecond := pending[int]()
+	sequence := Sequence(collection.OfSlice([]Future[int]{first, second, SuccessfulFuture(3)}))
+	completeSecond(control.SuccessOf(2))
+	completeFirst(control.SuccessOf(1))
+	list, err := await(t, sequence).OrElseCause()
+	assert.NilError(t, err)
+	assert.Assert(t, list.Equals(collection.OfSlice([]int{1, 2, 3})), "values should keep the order of the Futures, got %v", list)
+
+	never, _ := pending[int]()
+	failed := Sequence(collection.OfSlice([]Future[int]{never, FailedFuture[int](errBoom)}))
+	assert.Equal(t, await(t, failed).IsFailure(), true, "Sequence should fail without waiting for the other Futures")
+	_, err = await(t, failed).OrElseCause()
+	assert.Assert(t, errors.Is(err, errBoom))
+
+	empty, err := await(t, Sequence(collection.Empty[Future[int]]())).OrElseCause()
+	assert.NilError(t, err)
+	assert.Assert(t, empty.IsEmpty())
+}
+
+func TestAny(t *testing.T) {
+	never, _ := pending[int]()
+	assert.Equal(t, await(t, Any(collection.OfSlice([]Future[int]{never, FailedFuture[int](errBoom), SuccessfulFuture(2)}))), control.SuccessOf(2))
+
+	_, err := await(t, Any(collection.OfSlice([]Future[int]{FailedFuture[int](errBoom), FailedFuture[int](errOther)}))).OrElseCause()
+	assert.Assert(t, errors.Is(err, errBoom))
+	assert.Assert(t, errors.Is(err, errOther))
+	assert.Error(t, err, "boom\nother")
+
+	assert.Equal(t, await(t, Any(collection.Empty[Future[int]]())), control.FailureOf[int](ErrNoFuture))
+}
+
+func TestRace(t *testing.T) {
+	never, _ := pending[int]()
+	assert.Equal(t, await(t, Race(collection.OfSlice([]Future[int]{never, FailedFuture[int](errBoom)}))), control.FailureOf[int](errBoom))
+	assert.Equal(t, await(t, Race(collection.OfSlice([]Future[int]{never, SuccessfulFuture(1)}))), control.SuccessOf(1))
+	assert.Equal(t, await(t, Race(collection.Empty[Future[int]]())), control.FailureOf[int](ErrNoFuture))
+}
+
+func TestFanOut(t *testing.T) {
+	futures := collection.MapList(collection.Tabulate(100, func(i int) int { return i }), func(value int) Future[int] {
+		return Async(context.Background(), func() (int, error) {
+			time.Sleep(time.Millisecond)
+			return value * value, nil
+		})
+	})
+	sum := MapFuture(Sequence(futures), collection.Sum[int])
+	assert.Equal(t, await(t, sum), control.SuccessOf(328350))
+}
@@ -0,0 +1,114 @@
+package concurrent
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync/atomic"
+
+	"glours/go2funk/api/collection"
+	"glours/go2funk/api/control"
+)
+
+// ParMap maps the elements of the List[T] to elements of a new type U in parallel, preserving their order.
+// the failure of the returned Try has the cause of a panicking mapper or of the context if it's done before the end.
+// the options are the ones of TaskGroup, WithMaxConcurrency sets the number of workers which is GOMAXPROCS by default.
+func ParMap[T, U any](ctx context.Context, list collection.List[T], mapper func(T) U, options ...TaskGroupOption) control.Try[collection.List[U]] {
+	return ParTraverseTry(ctx, list, func(_ context.Context, value T) control.Try[U] {
+		return control.SuccessOf(mapper(value))
+	}, options...)
+}
+
+// ParFilter returns a List containing only the elements validating the predicate, evaluated in parallel, preserving their order.
+// the options are the ones of TaskGroup, WithMaxConcurrency sets the number of workers which is GOMAXPROCS by default.
+func ParFilter[T any](ctx context.Context, list collection.List[T], predicate func(T) bool, options ...TaskGroupOption) control.Try[collection.List[T]] {
+	elements := collection.ToSlice[T](list)
+	return control.MapTry(ParMap(ctx, list, predicate, options...), func(kept collection.List[bool]) collection.List[T] {
+		filtered := elements[:0]
+		index := 0
+		kept.ForEachWhile(func(keep bool) bool {
+			if keep {
+				filtered = append(filtered, elements[index])
+			}
+			index++
+			return true
+		})
+		return collection.OfSlice(filtered)
+	})
+}
+
+// ParFlatMap maps each element of the List[T] to a List[U] in parallel and concatenates the results preserving their order.
+// the options are the ones of TaskGroup, WithMaxConcurrency sets the number of workers which is GOMAXPROCS by default.
+func ParFlatMap[T, U any](ctx context.Context, list collection.List[T], mapper func(T) collection.List[U], options ...TaskGroupOption) control.Try[collection.List[U]] {
+	return control.MapTry(ParMap(ctx, list, mapper, options...), collection.Flatten[U])
+}
+
+// ParTraverseTry applies the function to the elements of the List[T] in parallel and returns a successful Try with the
+// values in the order of the elements if all the results are successful.
+// by default the remaining elements are skipped and the cause of the first failure is returned, with
+// CollectAllFailures all the elements are processed and the causes of all the failures are joined in the order of the elements.
+// the function receives a context cancelled on the first failure or when the context passed as parameter is done,
+// and a panic in the function is turned into a failure with a PanicError cause.
+// the options are the ones of TaskGroup, WithMaxConcurrency sets the number of workers which is GOMAXPROCS by default.
+func ParTraverseTry[T, U any](ctx context.Context, list collection.List[T], f func(context.Context, T) control.Try[U], options ...TaskGroupOption) control.Try[collection.List[U]] {
+	var config taskGroupConfig
+	for _, option := range options {
+		option(&config)
+	}
+	elements := collection.ToSlice[T](list)
+	workers := config.maxConcurrency
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > len(elements) {
+		workers = len(elements)
+	}
+
+	results := make([]control.Try[U], len(elements))
+	processed := make([]bool, len(elements))
+	var next atomic.Int64
+	group := NewTaskGroup[struct{}](ctx, options...)
+	for worker := 0; worker < workers; worker++ {
+		group.Go(func(ctx context.Context) (struct{}, error) {
+			for index := next.Add(1) - 1; index < int64(len(elements)); index = next.Add(1) - 1 {
+				if ctx.Err() != nil {
+					return struct{}{}, context.Cause(ctx)
+				}
+				element := elements[index]
+				results[index] = protect(func() (U, error) {
+					return f(ctx, element).OrElseCause()
+				})
+				processed[index] = true
+				if _, err := results[index].OrElseCause(); err != nil && !config.collectAll {
+					return struct{}{}, err
+				}
+			}
+			return struct{}{}, nil
+		})
+	}
+	if _, err := group.Wait().OrElseCause(); err != nil && !config.collectAll {
+		return control.FailureOf[collection.List[U]](err)
+	}
+
+	values := make([]U, len(elements))
+	var causes []error
+	skipped := false
+	for index, result := range results {
+		if !processed[index] {
+			skipped = true
+			continue
+		}
+		value, err := result.OrElseCause()
+		if err != nil {
+			causes = append(causes, err)
+		}
+		values[index] = value
+	}
+	if skipped {
+		causes = append(causes, context.Cause(ctx))
+	}
+	if len(causes) > 0 {
+		return control.FailureOf[collection.List[U]](errors.Join(causes...))
+	}
+	return control.SuccessOf(collection.OfSlice(values))
+}
@@ -0,0 +1,146 @@
+package concurrent
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"glours/go2funk/api/collection"
+	"glours/go2funk/api/control"
+	"gotest.tools/v3/assert"
+)
+
+var numbers = collection.Tabulate(100, func(i int) int { return i + 1 })
+
+func TestParallelOperations(t *testing.T) {
+	testCases := []struct {
+		name     string
+		result   control.Try[collection.List[string]]
+		expected collection.List[string]
+	}{
+		{
+			name:     "ParMap",
+			result:   ParMap(context.Background(), numbers, strconv.Itoa),
+			expected: collection.MapList(numbers, strconv.Itoa),
+		},
+		{
+			name:     "ParMap on empty List",
+			result:   ParMap(context.Background(), collection.Empty[int](), strconv.Itoa),
+			expected: collection.Empty[string](),
+		},
+		{
+			name:     "ParMap with a single worker",
+			result:   ParMap(context.Background(), numbers, strconv.Itoa, WithMaxConcurrency(1)),
+			expected: collection.MapList(numbers, strconv.Itoa),
+		},
+		{
+			name: "ParFilter",
+			result: control.MapTry(ParFilter(context.Background(), numbers, func(value int) bool { return value%10 == 0 }, WithMaxConcurrency(4)),
+				func(list collection.List[int]) collection.List[string] { return collection.MapList(list, strconv.Itoa) }),
+			expected: collection.OfSlice([]string{"10", "20", "30", "40", "50", "60", "70", "80", "90", "100"}),
+		},
+		{
+			name: "ParFlatMap",
+			result: ParFlatMap(context.Background(), collection.OfSlice([]int{1, 2, 3}), func(value int) collection.List[string] {
+				return collection.Fill(value, strconv.Itoa(value))
+			}),
+			expected: collection.OfSlice([]string{"1", "2", "2", "3", "3", "3"}),
+		},
+		{
+			name: "ParTraverseTry",
+			result: ParTraverseTry(context.Background(), numbers, func(_ context.Context, value int) control.Try[string] {
+				time.Sleep(time.Duration(value%3) * time.Millisecond)
+				return control.SuccessOf(strconv.Itoa(value))
+			}, WithMaxConcurrency(8)),
+			expected: collection.MapList(numbers, strconv.Itoa),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			list, err := testCase.result.OrElseCause()
+			assert.NilError(t, err)
+			assert.Assert(t, list.Equals(testCase.expected), "expected %v but value is %v", testCase.expected, list)
+		})
+	}
+}
+
+func TestParTraverseTryFailures(t *testing.T) {
+	failOn := func(failures ...int) func(context.Context, int) control.Try[int] {
+		return func(_ context.Context, value int) control.Try[int] {
+			for _, failure := range failures {
+				if value == failure {
+					return control.FailureOf[int](errors.New("failed on " + strconv.Itoa(value)))
+				}
+			}
+			return control.SuccessOf(value)
+		}
+	}
+
+	_, err := ParTraverseTry(context.Background(), numbers, failOn(50), WithMaxConcurrency(4)).OrElseCause()
+	assert.Error(t, err, "failed on 50")
+
+	_, err = ParTraverseTry(context.Background(), numbers, failOn(70, 20, 50), CollectAllFailures()).OrElseCause()
+	assert.Error(t, err, "failed on 20\nfailed on 50\nfailed on 70")
+
+	_, err = ParMap(context.Background(), numbers, func(value int) int {
+		if value == 42 {
+			panic("unexpected value")
+		}
+		return value
+	}).OrElseCause()
+	var panicError *PanicError
+	assert.Assert(t, errors.As(err, &panicError))
+	assert.Equal(t, panicError.Value, "unexpected value")
+}
+
+func TestParTraverseTryStopsOnFirstFailure(t *testing.T) {
+	var calls atomic.Int32
+	_, err := ParTraverseTry(context.Background(), numbers, func(_ context.Context, value int) control.Try[int] {
+		calls.Add(1)
+		return control.FailureOf[int](errBoom)
+	}, WithMaxConcurrency(2)).OrElseCause()
+	assert.Equal(t, err, errBoom)
+	assert.Assert(t, calls.Load() <= 2, "remaining elements should be skipped, got %d calls", calls.Load())
+}
+
+func TestParTraverseTryHonoursContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls atomic.Int32
+	f := func(ctx context.Context, value int) control.Try[int] {
+		if calls.Add(1) == 10 {
+			cancel()
+		}
+		return control.SuccessOf(value)
+	}
+	_, err := ParTraverseTry(ctx, numbers, f, WithMaxConcurrency(1)).OrElseCause()
+	assert.Assert(t, errors.Is(err, context.Canceled))
+	assert.Equal(t, calls.Load(), int32(10))
+
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	calls.Store(0)
+	_, err = ParTraverseTry(ctx, numbers, f, WithMaxConcurrency(1), CollectAllFailures()).OrElseCause()
+	assert.Assert(t, errors.Is(err, context.Canceled))
+}
+
+func BenchmarkMapList(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		collection.MapList(numbers, slowSquare)
+	}
+}
+
+func BenchmarkParMap(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		ParMap(context.Background(), numbers, slowSquare, WithMaxConcurrency(16))
+	}
+}
+
+func slowSquare(value int) int {
+	time.Sleep(100 * time.Microsecond)
+	return value * value
+}
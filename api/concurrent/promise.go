@@ -0,0 +1,56 @@
+// Package concurrent provides asynchronous and concurrent structures built on the control types, such as Future.
+package concurrent
+
+import (
+	"sync"
+
+	"glours/go2funk/api/control"
+)
+
+// Promise is the writable side of a Future, completed once with a Try by its owner.
+// NewPromise should be used to build a Promise.
+type Promise[A any] struct {
+	state *futureState[A]
+}
+
+// futureState is the internal state shared by a Promise and its Future.
+// result is written once before closing done, so it can be read without lock once done is closed.
+type futureState[A any] struct {
+	done   chan struct{}
+	once   sync.Once
+	result control.Try[A]
+}
+
+// NewPromise returns a new Promise whose Future isn't completed yet.
+func NewPromise[A any]() *Promise[A] {
+	return &Promise[A]{state: &futureState[A]{done: make(chan struct{})}}
+}
+
+// Future returns the Future completed by the current Promise.
+func (p *Promise[A]) Future() Future[A] {
+	return Future[A]{state: p.state}
+}
+
+// Complete completes the Future of the current Promise with the Try passed as parameter.
+// it returns false if the Promise was already completed, in which case the result is ignored.
+func (p *Promise[A]) Complete(result control.Try[A]) bool {
+	completed := false
+	p.state.once.Do(func() {
+		p.state.result = result
+		close(p.state.done)
+		completed = true
+	})
+	return completed
+}
+
+// Success completes the Future of the current Promise with a successful Try containing the value passed as parameter.
+// it returns false if the Promise was already completed.
+func (p *Promise[A]) Success(value A) bool {
+	return p.Complete(control.SuccessOf(value))
+}
+
+// Failure completes the Future of the current Promise with a failed Try with the cause passed as parameter.
+// it returns false if the Promise was already completed.
+func (p *Promise[A]) Failure(cause error) bool {
+	return p.Complete(control.FailureOf[A](cause))
+}
@@ -0,0 +1,62 @@
+package concurrent
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"glours/go2funk/api/control"
+	"gotest.tools/v3/assert"
+)
+
+var errBoom = errors.New("boom")
+
+func TestPromise(t *testing.T) {
+	testCases := []struct {
+		name     string
+		complete func(*Promise[int]) bool
+		expected control.Try[int]
+	}{
+		{name: "Success", complete: func(p *Promise[int]) bool { return p.Success(10) }, expected: control.SuccessOf(10)},
+		{name: "Failure", complete: func(p *Promise[int]) bool { return p.Failure(errBoom) }, expected: control.FailureOf[int](errBoom)},
+		{
+			name:     "Complete",
+			complete: func(p *Promise[int]) bool { return p.Complete(control.SuccessOf(5)) },
+			expected: control.SuccessOf(5),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			promise := NewPromise[int]()
+			future := promise.Future()
+			assert.Assert(t, !future.IsCompleted())
+			assert.Assert(t, future.Value().IsEmpty())
+
+			assert.Assert(t, testCase.complete(promise))
+			assert.Assert(t, future.IsCompleted())
+			assert.Equal(t, future.Await(context.Background()), testCase.expected)
+			assert.Equal(t, future.Value(), control.Of(testCase.expected))
+
+			assert.Assert(t, !promise.Success(20), "a Promise should only be completed once")
+			assert.Equal(t, future.Await(context.Background()), testCase.expected)
+		})
+	}
+}
+
+func TestPromiseCompletedConcurrently(t *testing.T) {
+	promise := NewPromise[int]()
+	completed := make(chan bool)
+	for i := 0; i < 10; i++ {
+		go func(value int) {
+			completed <- promise.Success(value)
+		}(i)
+	}
+	winners := 0
+	for i := 0; i < 10; i++ {
+		if <-completed {
+			winners++
+		}
+	}
+	assert.Equal(t, winners, 1)
+	assert.Assert(t, !promise.Future().Await(context.Background()).IsFailure())
+}
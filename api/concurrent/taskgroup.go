@@ -0,0 +1,187 @@
+package concurrent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"sync"
+
+	"glours/go2funk/api/collection"
+	"glours/go2funk/api/control"
+)
+
+// PanicError is the cause of the failure of a task which panicked, with the recovered value and the stack trace of the panic.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+// Error returns the recovered value followed by the stack trace of the panic.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("task panicked: %v\n\n%s", e.Value, e.Stack)
+}
+
+// Unwrap returns the recovered value if it's an error, so it can be matched with errors.Is or errors.As.
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// TaskGroupOption configures the behaviour of a TaskGroup.
+type TaskGroupOption func(*taskGroupConfig)
+
+// taskGroupConfig is the internal configuration of a TaskGroup.
+type taskGroupConfig struct {
+	maxConcurrency int
+	collectAll     bool
+}
+
+// WithMaxConcurrency bounds the number of tasks of a TaskGroup running at the same time, a value less than or equal
+// to 0 means no bound.
+// for the parallel List operations, it's the number of workers.
+func WithMaxConcurrency(n int) TaskGroupOption {
+	return func(config *taskGroupConfig) {
+		config.maxConcurrency = n
+	}
+}
+
+// CollectAllFailures makes a TaskGroup run all its tasks even if some of them fail, instead of cancelling them on the
+// first failure, and report the causes of all the failures.
+func CollectAllFailures() TaskGroupOption {
+	return func(config *taskGroupConfig) {
+		config.collectAll = true
+	}
+}
+
+// TaskGroup is a scope running tasks in their own goroutines and waiting for all of them.
+// by default the context of the tasks is cancelled on the first failure, and the tasks which haven't started yet are
+// not executed anymore.
+// a panic in a task is recovered and turned into a failure with a PanicError cause.
+// running tasks can submit new tasks to their TaskGroup, which can't be used anymore once Wait returned.
+// NewTaskGroup should be used to build a TaskGroup.
+type TaskGroup[A any] struct {
+	ctx        context.Context
+	cancel     context.CancelCauseFunc
+	config     taskGroupConfig
+	slots      chan struct{}
+	lock       sync.Mutex
+	idle       sync.Cond
+	active     int
+	results    []control.Try[A]
+	firstCause error
+	waited     bool
+}
+
+// NewTaskGroup returns a new TaskGroup whose tasks receive a context derived from the one passed as parameter.
+func NewTaskGroup[A any](ctx context.Context, options ...TaskGroupOption) *TaskGroup[A] {
+	group := &TaskGroup[A]{}
+	group.idle.L = &group.lock
+	for _, option := range options {
+		option(&group.config)
+	}
+	group.ctx, group.cancel = context.WithCancelCause(ctx)
+	if group.config.maxConcurrency > 0 {
+		group.slots = make(chan struct{}, group.config.maxConcurrency)
+	}
+	return group
+}
+
+// Go submits a task to the current TaskGroup without blocking, the task is started as soon as the concurrency bound allows it.
+// the task should stop as soon as possible once the context passed as parameter is done.
+// it panics if Wait already returned, or if it's waiting while no task is running anymore.
+func (g *TaskGroup[A]) Go(task func(ctx context.Context) (A, error)) {
+	g.lock.Lock()
+	if g.waited {
+		g.lock.Unlock()
+		panic("concurrent: TaskGroup used after Wait")
+	}
+	index := len(g.results)
+	g.results = append(g.results, control.Try[A]{})
+	g.active++
+	g.lock.Unlock()
+
+	go g.run(index, task)
+}
+
+// Wait waits for all the submitted tasks and returns a successful Try with their values in submission order, or a failure.
+// by default the failure has the cause of the first task which failed, with CollectAllFailures it has the causes of
+// all the failed tasks joined in submission order.
+func (g *TaskGroup[A]) Wait() control.Try[collection.List[A]] {
+	g.lock.Lock()
+	for g.active > 0 {
+		g.idle.Wait()
+	}
+	g.waited = true
+	g.lock.Unlock()
+	g.cancel(context.Canceled)
+
+	if !g.config.collectAll && g.firstCause != nil {
+		return control.FailureOf[collection.List[A]](g.firstCause)
+	}
+	values := make([]A, len(g.results))
+	var causes []error
+	for index, result := range g.results {
+		value, err := result.OrElseCause()
+		if err != nil {
+			causes = append(causes, err)
+		}
+		values[index] = value
+	}
+	if len(causes) > 0 {
+		return control.FailureOf[collection.List[A]](errors.Join(causes...))
+	}
+	return control.SuccessOf(collection.OfSlice(values))
+}
+
+// run is an internal function executing the task at the index once a slot is available, unless the context is done
+// before, and recording its result before releasing the slot so that no pending task starts after a failure.
+func (g *TaskGroup[A]) run(index int, task func(ctx context.Context) (A, error)) {
+	if g.slots != nil {
+		select {
+		case g.slots <- struct{}{}:
+			defer func() { <-g.slots }()
+		case <-g.ctx.Done():
+			g.complete(index, control.FailureOf[A](context.Cause(g.ctx)))
+			return
+		}
+	}
+	if g.ctx.Err() != nil {
+		g.complete(index, control.FailureOf[A](context.Cause(g.ctx)))
+		return
+	}
+	g.complete(index, protect(func() (A, error) {
+		return task(g.ctx)
+	}))
+}
+
+// protect is an internal function returning a Try depending of the execution result of the lambda, like control.TryOf,
+// turning a panic into a failure with a PanicError cause.
+func protect[A any](lambda func() (A, error)) (result control.Try[A]) {
+	defer func() {
+		if value := recover(); value != nil {
+			result = control.FailureOf[A](&PanicError{Value: value, Stack: debug.Stack()})
+		}
+	}()
+	return control.TryOf(lambda)
+}
+
+// complete is an internal function recording the result of the task at the index, waking Wait up once no task is
+// running anymore, and cancelling the other tasks on the first failure unless all the failures are collected.
+func (g *TaskGroup[A]) complete(index int, result control.Try[A]) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	g.results[index] = result
+	g.active--
+	if g.active == 0 {
+		g.idle.Broadcast()
+	}
+	_, err := result.OrElseCause()
+	if err == nil || g.config.collectAll || g.firstCause != nil {
+		return
+	}
+	g.firstCause = err
+	g.cancel(err)
+}
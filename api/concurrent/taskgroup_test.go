@@ -0,0 +1,179 @@
+package concurrent
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"glours/go2funk/api/collection"
+	"gotest.tools/v3/assert"
+)
+
+func constant(value int, delay time.Duration) func(context.Context) (int, error) {
+	return func(ctx context.Context) (int, error) {
+		select {
+		case <-time.After(delay):
+			return value, nil
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}
+}
+
+func failing(cause error) func(context.Context) (int, error) {
+	return func(context.Context) (int, error) {
+		return 0, cause
+	}
+}
+
+func TestTaskGroupKeepsSubmissionOrder(t *testing.T) {
+	group := NewTaskGroup[int](context.Background())
+	group.Go(constant(1, 20*time.Millisecond))
+	group.Go(constant(2, 0))
+	group.Go(constant(3, 10*time.Millisecond))
+	list, err := group.Wait().OrElseCause()
+	assert.NilError(t, err)
+	assert.Assert(t, list.Equals(collection.OfSlice([]int{1, 2, 3})), "unexpected value %v", list)
+
+	empty, err := NewTaskGroup[int](context.Background()).Wait().OrElseCause()
+	assert.NilError(t, err)
+	assert.Assert(t, empty.IsEmpty())
+}
+
+func TestTaskGroupCancelsOnFirstFailure(t *testing.T) {
+	group := NewTaskGroup[int](context.Background())
+	var cancelled atomic.Bool
+	started := make(chan struct{})
+	group.Go(func(ctx context.Context) (int, error) {
+		close(started)
+		<-ctx.Done()
+		cancelled.Store(true)
+		assert.Assert(t, errors.Is(context.Cause(ctx), errBoom))
+		return 0, ctx.Err()
+	})
+	<-started
+	group.Go(failing(errBoom))
+	_, err := group.Wait().OrElseCause()
+	assert.Equal(t, err, errBoom)
+	assert.Assert(t, cancelled.Load(), "sibling tasks should be cancelled")
+}
+
+func TestTaskGroupCollectsAllFailures(t *testing.T) {
+	group := NewTaskGroup[int](context.Background(), CollectAllFailures())
+	var completed atomic.Int32
+	group.Go(failing(errBoom))
+	group.Go(func(ctx context.Context) (int, error) {
+		value, err := constant(2, 10*time.Millisecond)(ctx)
+		completed.Add(1)
+		return value, err
+	})
+	group.Go(failing(errOther))
+	_, err := group.Wait().OrElseCause()
+	assert.Error(t, err, "boom\nother")
+	assert.Equal(t, completed.Load(), int32(1))
+}
+
+func TestTaskGroupBoundsConcurrency(t *testing.T) {
+	group := NewTaskGroup[int](context.Background(), WithMaxConcurrency(3))
+	var running, maxRunning atomic.Int32
+	for i := 0; i < 20; i++ {
+		group.Go(func(ctx context.Context) (int, error) {
+			current := running.Add(1)
+			defer running.Add(-1)
+			for {
+				max := maxRunning.Load()
+				if current <= max || maxRunning.CompareAndSwap(max, current) {
+					break
+				}
+			}
+			return constant(1, time.Millisecond)(ctx)
+		})
+	}
+	list, err := group.Wait().OrElseCause()
+	assert.NilError(t, err)
+	assert.Equal(t, collection.Sum(list), 20)
+	assert.Assert(t, maxRunning.Load() <= 3, "at most 3 tasks should run at the same time, got %d", maxRunning.Load())
+}
+
+func TestTaskGroupSkipsPendingTasksAfterFailure(t *testing.T) {
+	group := NewTaskGroup[int](context.Background(), WithMaxConcurrency(1))
+	var started atomic.Int32
+	running, release := make(chan struct{}), make(chan struct{})
+	group.Go(func(context.Context) (int, error) {
+		close(running)
+		<-release
+		return 0, errBoom
+	})
+	// the failing task holds the only slot until all the others are pending
+	<-running
+	for i := 0; i < 5; i++ {
+		group.Go(func(ctx context.Context) (int, error) {
+			started.Add(1)
+			return 1, nil
+		})
+	}
+	close(release)
+	_, err := group.Wait().OrElseCause()
+	assert.Equal(t, err, errBoom)
+	assert.Equal(t, started.Load(), int32(0), "pending tasks should not be started after the failure")
+}
+
+func TestTaskGroupRecoversPanics(t *testing.T) {
+	group := NewTaskGroup[int](context.Background())
+	group.Go(func(context.Context) (int, error) {
+		panic("unexpected state")
+	})
+	_, err := group.Wait().OrElseCause()
+	var panicError *PanicError
+	assert.Assert(t, errors.As(err, &panicError))
+	assert.Equal(t, panicError.Value, "unexpected state")
+	assert.Assert(t, strings.Contains(string(panicError.Stack), "taskgroup_test.go"), "stack trace should point to the panic")
+	assert.Assert(t, strings.HasPrefix(err.Error(), "task panicked: unexpected state"))
+
+	group = NewTaskGroup[int](context.Background())
+	group.Go(func(context.Context) (int, error) {
+		panic(errBoom)
+	})
+	_, err = group.Wait().OrElseCause()
+	assert.Assert(t, errors.Is(err, errBoom), "a panic with an error should unwrap to it")
+}
+
+func TestTaskGroupHonoursParentContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	group := NewTaskGroup[int](ctx)
+	group.Go(constant(1, time.Hour))
+	_, err := group.Wait().OrElseCause()
+	assert.Assert(t, errors.Is(err, context.Canceled))
+}
+
+func TestTaskGroupTasksSubmitSiblings(t *testing.T) {
+	group := NewTaskGroup[int](context.Background())
+	var spawn func(depth int) func(context.Context) (int, error)
+	spawn = func(depth int) func(context.Context) (int, error) {
+		return func(context.Context) (int, error) {
+			time.Sleep(time.Millisecond)
+			if depth < 3 {
+				group.Go(spawn(depth + 1))
+			}
+			return depth, nil
+		}
+	}
+	group.Go(spawn(0))
+	values, err := group.Wait().OrElseCause()
+	assert.NilError(t, err)
+	assert.Assert(t, values.Equals(collection.OfSlice([]int{0, 1, 2, 3})), "unexpected values %v", values)
+}
+
+func TestTaskGroupUsedAfterWait(t *testing.T) {
+	group := NewTaskGroup[int](context.Background())
+	group.Wait()
+	defer func() {
+		assert.Equal(t, recover(), "concurrent: TaskGroup used after Wait")
+	}()
+	group.Go(constant(1, 0))
+	t.Fatal("Go should panic after Wait")
+}
@@ -1,153 +1,117 @@
 // Package control provides control structures such as Option, Try or Either...
 package control
 
-// Either represents a value of two possible types.
-// internal implementations of Either are Right and Left.
-type Either[L, R any] interface {
-	IsLeft() bool
-	IsRight() bool
-	OrElse(Either[L, R]) Either[L, R]
-	GetOrElse(other R) R
-	GetLeftOrElse(other L) L
-	Swap() Either[R, L]
-	FilterOrElse(func(R) bool, func(R) L) Either[L, R]
-	Filter(func(R) bool) Option[Either[L, R]]
+import (
+	"fmt"
+
+	"glours/go2funk/api/internal/gostring"
+)
+
+// Either represents a value of two possible types, a "left" one or a "right" one.
+// the zero value of an Either is a Left with the zero value of L, LeftOf and RightOf should be used to build them.
+type Either[L, R any] struct {
+	left    L
+	right   R
+	isRight bool
 }
 
 // MapEither maps the Right element of a Either[L,R] to a new Either with a right element of type U.
 // the mapper function should take a R value and return a U value.
 func MapEither[L, R, U any](either Either[L, R], mapper func(R) U) Either[L, U] {
-	if either.IsRight() {
-		return Right[L, U]{mapper(either.GetOrElse(*new(R)))}
+	if either.isRight {
+		return Either[L, U]{right: mapper(either.right), isRight: true}
 	}
-	return Left[L, U]{either.GetLeftOrElse(*new(L))}
+	return Either[L, U]{left: either.left}
 }
 
 // FlatMapEither maps the Right element of a Either[L,R] to a new Either with a right element of type U.
 // the mapper function should take a R value and return a Either[L,U] value.
 func FlatMapEither[L, R, U any](either Either[L, R], mapper func(R) Either[L, U]) Either[L, U] {
-	if either.IsRight() {
-		return mapper(either.GetOrElse(*new(R)))
+	if either.isRight {
+		return mapper(either.right)
 	}
-	return Left[L, U]{either.GetLeftOrElse(*new(L))}
+	return Either[L, U]{left: either.left}
 }
 
 // RightOf return a Either[L,R] with the right value set.
 func RightOf[L, R any](value R) Either[L, R] {
-	return Right[L, R]{value}
+	return Either[L, R]{right: value, isRight: true}
 }
 
 // LeftOf return a Either[L,R] with the left value set.
 func LeftOf[L, R any](value L) Either[L, R] {
-	return Left[L, R]{value}
-}
-
-// Right is an implementation of Either with a "right" value initialized
-type Right[L, R any] struct {
-	value R
+	return Either[L, R]{left: value}
 }
 
 // IsLeft checks if the current Either contains a "left" value or not
-// Right implementation always return false
-func (r Right[L, R]) IsLeft() bool {
-	return false
+func (e Either[L, R]) IsLeft() bool {
+	return !e.isRight
 }
 
 // IsRight checks if the current Either contains a "right" value or not
-// Right implementation always return true
-func (r Right[L, R]) IsRight() bool {
-	return true
+func (e Either[L, R]) IsRight() bool {
+	return e.isRight
 }
 
 // Swap converts a Right Either to a Left one and vis versa
-// Right implementation return a new Left Either setup with the previous "right" value.
-func (r Right[L, R]) Swap() Either[R, L] {
-	return LeftOf[R, L](r.value)
+func (e Either[L, R]) Swap() Either[R, L] {
+	return Either[R, L]{left: e.right, right: e.left, isRight: !e.isRight}
 }
 
 // OrElse returns the current Either if it's a Right one or the Either passed as parameter.
-// Right implementation always return the current Either.
-func (r Right[L, R]) OrElse(other Either[L, R]) Either[L, R] {
-	return r
+func (e Either[L, R]) OrElse(other Either[L, R]) Either[L, R] {
+	if e.isRight {
+		return e
+	}
+	return other
 }
 
 // GetOrElse returns the "right" value of the current Either or the "other" value passed as parameter.
-// Right implementation always return the current Either "right" value.
-func (r Right[L, R]) GetOrElse(other R) R {
-	return r.value
+func (e Either[L, R]) GetOrElse(other R) R {
+	if e.isRight {
+		return e.right
+	}
+	return other
 }
 
 // FilterOrElse turns a Right Either into a Left one if the "right" value does not make it through the predicate.
-func (r Right[L, R]) FilterOrElse(predicate func(R) bool, transform func(R) L) Either[L, R] {
-	if predicate(r.value) {
-		return r
+// a Left Either is returned unchanged.
+func (e Either[L, R]) FilterOrElse(predicate func(R) bool, transform func(R) L) Either[L, R] {
+	if !e.isRight || predicate(e.right) {
+		return e
 	}
-	return LeftOf[L, R](transform(r.value))
+	return LeftOf[L, R](transform(e.right))
 }
 
 // Filter returns an Option with the current either if the "right" value matches the predicate.
-func (r Right[L, R]) Filter(predicate func(R) bool) Option[Either[L, R]] {
-	if predicate(r.value) {
-		return Of[Either[L, R]](r)
+// a Left Either always returns a empty Option.
+func (e Either[L, R]) Filter(predicate func(R) bool) Option[Either[L, R]] {
+	if e.isRight && predicate(e.right) {
+		return Of(e)
 	}
 	return Empty[Either[L, R]]()
 }
 
 // GetLeftOrElse return the "left" value of a Left Either or the "other" value passed as parameter.
-// Right implementation always return the "other" value passed as parameter.
-func (r Right[L, R]) GetLeftOrElse(other L) L {
-	return other
-}
-
-// Left is an implementation of Either with a "left" value initialized
-type Left[L, R any] struct {
-	value L
-}
-
-// IsLeft checks if the current Either contains a "left" value or not
-// Left implementation always return true
-func (l Left[L, R]) IsLeft() bool {
-	return true
-}
-
-// IsRight checks if the current Either contains a "right" value or not
-// Left implementation always return false
-func (l Left[L, R]) IsRight() bool {
-	return false
-}
-
-// Swap converts a right Either to a left one and vis versa
-// Left implementation return a new Right Either setup with the previous "left" value.
-func (l Left[L, R]) Swap() Either[R, L] {
-	return RightOf[R, L](l.value)
-}
-
-// OrElse returns the current Either if it's a Right one or the Either passed as parameter.
-// Left implementation always return the Either passed as parameter.
-func (l Left[L, R]) OrElse(other Either[L, R]) Either[L, R] {
-	return other
-}
-
-// GetOrElse returns the "right" value of the current Either or the "other" value passed as parameter.
-// Left implementation always return the "other" value passed as parameter.
-func (l Left[L, R]) GetOrElse(other R) R {
-	return other
-}
-
-// FilterOrElse turns a Right Either into a Left one if the "right" value does not make it through the predicate.
-// Left implementation always return the current Either.
-func (l Left[L, R]) FilterOrElse(predicate func(R) bool, transform func(R) L) Either[L, R] {
-	return l
+func (e Either[L, R]) GetLeftOrElse(other L) L {
+	if e.isRight {
+		return other
+	}
+	return e.left
 }
 
-// Filter returns an Option with the current either if the "right" value matches the predicate.
-// Left implementation always returns a empty Option.
-func (l Left[L, R]) Filter(predicate func(R) bool) Option[Either[L, R]] {
-	return Empty[Either[L, R]]()
+// String returns a readable representation of the Either, "Left(value)" or "Right(value)".
+func (e Either[L, R]) String() string {
+	if e.isRight {
+		return fmt.Sprintf("Right(%v)", e.right)
+	}
+	return fmt.Sprintf("Left(%v)", e.left)
 }
 
-// GetLeftOrElse return the "left" value of a Left Either or the "other" value passed as parameter.
-// Left implementation always return the left value of the current Either.
-func (l Left[L, R]) GetLeftOrElse(other L) L {
-	return l.value
+// GoString returns the Go syntax representation of the Either.
+func (e Either[L, R]) GoString() string {
+	if e.isRight {
+		return fmt.Sprintf("control.RightOf[%s, %s](%s)", gostring.TypeName[L](), gostring.TypeName[R](), gostring.Value(e.right))
+	}
+	return fmt.Sprintf("control.LeftOf[%s, %s](%s)", gostring.TypeName[L](), gostring.TypeName[R](), gostring.Value(e.left))
 }
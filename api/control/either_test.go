@@ -9,11 +9,9 @@ import (
 )
 
 var (
-	defaultEitherError                    = errors.New("default Either error")
-	_                  Either[error, int] = Right[error, int]{10}
-	_                  Either[error, int] = Left[error, int]{defaultEitherError}
-	right                                 = RightOf[error, int](10)
-	left                                  = LeftOf[error, int](defaultEitherError)
+	defaultEitherError = errors.New("default Either error")
+	right              = RightOf[error, int](10)
+	left               = LeftOf[error, int](defaultEitherError)
 )
 
 func TestIsRight(t *testing.T) {
@@ -21,6 +19,13 @@ func TestIsRight(t *testing.T) {
 	assert.Assert(t, !left.IsRight(), "should be a Left not Right")
 }
 
+func TestZeroEither(t *testing.T) {
+	var zero Either[string, int]
+	assert.Assert(t, zero.IsLeft(), "zero value should be a Left")
+	assert.Equal(t, zero.GetLeftOrElse("other"), "", "zero value should contain the zero left value")
+	assert.Equal(t, zero, LeftOf[string, int](""))
+}
+
 func TestIsLeft(t *testing.T) {
 	assert.Assert(t, !right.IsLeft(), "should be a Right not Left")
 	assert.Assert(t, left.IsLeft(), "should be a Left not Right")
@@ -81,3 +86,39 @@ func TestFlatMapEither(t *testing.T) {
 	var mapLeft = FlatMapEither[error, int, string](left, mapper)
 	assert.Assert(t, mapLeft.IsLeft(), "should be an Left Either")
 }
+
+func TestEitherString(t *testing.T) {
+	assert.Equal(t, right.String(), "Right(10)")
+	assert.Equal(t, left.String(), "Left(default Either error)")
+}
+
+func TestEitherGoString(t *testing.T) {
+	assert.Equal(t, fmt.Sprintf("%#v", right), "control.RightOf[error, int](10)")
+	assert.Equal(t, fmt.Sprintf("%#v", LeftOf[string, int]("left")), `control.LeftOf[string, int]("left")`)
+}
+
+var eitherSink Either[error, int]
+
+func TestEitherDoesNotAllocate(t *testing.T) {
+	allocations := testing.AllocsPerRun(100, func() {
+		eitherSink = MapEither(RightOf[error](1024), func(value int) int { return value + 1 })
+	})
+	assert.Equal(t, allocations, 0.0, "Either should not allocate")
+}
+
+func BenchmarkRightOf(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		eitherSink = RightOf[error](i + 1024)
+	}
+}
+
+func BenchmarkMapEither(b *testing.B) {
+	b.ReportAllocs()
+	var mapper = func(value int) int {
+		return value + 1
+	}
+	for i := 0; i < b.N; i++ {
+		eitherSink = MapEither(RightOf[error](i+1024), mapper)
+	}
+}
@@ -2,6 +2,7 @@ package control
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
 	"testing"
 
@@ -9,12 +10,10 @@ import (
 )
 
 var (
-	_    Option[int] = None[int]{}
-	_    Option[int] = Some[int]{value: 10}
-	_                = Of[int](10)
-	_                = Empty[int]()
-	some             = Of(10)
-	none             = Empty[int]()
+	_    = Of[int](10)
+	_    = Empty[int]()
+	some = Of(10)
+	none = Empty[int]()
 )
 
 func TestIsPresent(t *testing.T) {
@@ -22,6 +21,13 @@ func TestIsPresent(t *testing.T) {
 	assert.Assert(t, none.IsEmpty(), "No value should be present!")
 }
 
+func TestZeroOption(t *testing.T) {
+	var zero Option[int]
+	assert.Assert(t, zero.IsEmpty(), "zero value should be empty")
+	assert.Equal(t, zero, none)
+	assert.Equal(t, zero.OrElse(20), 20, "Value should be 20!")
+}
+
 func TestGetOrElse(t *testing.T) {
 	assert.Equal(t, some.OrElse(20), 10, "Value should be 10 not 20")
 	assert.Equal(t, none.OrElse(20), 20, "Value should be 20!")
@@ -60,3 +66,41 @@ func TestOptionFlatMap(t *testing.T) {
 	assert.Assert(t, !FlatMapOption[int, string](some, mapper).IsEmpty(), "result of FlatMapOption function should not be empty")
 	assert.Assert(t, FlatMapOption[int, string](none, mapper).IsEmpty(), "result of FlatMapOption function should be empty")
 }
+
+func TestOptionString(t *testing.T) {
+	assert.Equal(t, some.String(), "Some(10)")
+	assert.Equal(t, none.String(), "None")
+	assert.Equal(t, fmt.Sprint(Of(Of("nested"))), "Some(Some(nested))")
+}
+
+func TestOptionGoString(t *testing.T) {
+	assert.Equal(t, fmt.Sprintf("%#v", some), "control.Of[int](10)")
+	assert.Equal(t, fmt.Sprintf("%#v", none), "control.Empty[int]()")
+	assert.Equal(t, fmt.Sprintf("%#v", Of("ten")), `control.Of[string]("ten")`)
+}
+
+var optionSink Option[int]
+
+func TestOptionDoesNotAllocate(t *testing.T) {
+	allocations := testing.AllocsPerRun(100, func() {
+		optionSink = MapOption(Of(1024), func(value int) int { return value + 1 }).Filter(EvenPredicate)
+	})
+	assert.Equal(t, allocations, 0.0, "Option should not allocate")
+}
+
+func BenchmarkOf(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		optionSink = Of(i + 1024)
+	}
+}
+
+func BenchmarkMapOption(b *testing.B) {
+	b.ReportAllocs()
+	var mapper = func(value int) int {
+		return value + 1
+	}
+	for i := 0; i < b.N; i++ {
+		optionSink = MapOption(Of(i+1024), mapper).Filter(EvenPredicate)
+	}
+}
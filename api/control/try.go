@@ -1,123 +1,119 @@
 // Package control provides control structures such as Option, Try or Either...
 package control
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+
+	"glours/go2funk/api/internal/gostring"
+)
+
+// ErrEmptyTry is the cause of a Try which is neither a success nor a failure with a cause, such as the zero value of Try.
+var ErrEmptyTry = errors.New("empty Try without value nor cause")
 
 // Try control type allows user to write code without focusing on error management.
-// internal implementations of Try are Success and Failure.
-type Try[A any] interface {
-	IsFailure() bool
-	OrElse(A) A
-	OrElseCause() (A, error)
-	Filter(func(A) bool, error) Try[A]
+// a Try is either a success with a value or a failure with an error cause.
+// the zero value of a Try is a failure, SuccessOf, FailureOf and TryOf should be used to build them.
+type Try[A any] struct {
+	value   A
+	cause   error
+	success bool
 }
 
 // MapTry maps the element of a Try[A] to a new Try with element of type B.
 // the mapper function should take a A value and return a B value.
 func MapTry[A, B any](try Try[A], mapper func(A) B) Try[B] {
-	if try.IsFailure() {
-		return Failure[B]{}
+	if !try.success {
+		return Try[B]{cause: try.cause}
 	}
-	return Success[B]{mapper(try.OrElse(*new(A)))}
+	return Try[B]{value: mapper(try.value), success: true}
 }
 
 // FlatMapTry maps the element of a Try[A] to a new Try with element of type B.
 // the mapper function should take a A value and return a Try[B] as result.
 func FlatMapTry[A, B any](try Try[A], mapper func(A) Try[B]) Try[B] {
-	if try.IsFailure() {
-		return Failure[B]{}
+	if !try.success {
+		return Try[B]{cause: try.cause}
 	}
-	return mapper(try.OrElse(*new(A)))
+	return mapper(try.value)
 }
 
 // TryOf returns a Try[A] depending of the execution result of the lambda passed as parameter.
 func TryOf[A any](lambda func() (A, error)) Try[A] {
 	value, err := lambda()
 	if err != nil {
-		return Failure[A]{}
+		return Try[A]{cause: err}
 	}
-	return Success[A]{value}
+	return Try[A]{value: value, success: true}
 }
 
-// SuccessOf returns a Success[A] as Try[A].
+// SuccessOf returns a successful Try[A] containing the value passed as parameter.
 func SuccessOf[A any](value A) Try[A] {
-	return Success[A]{value}
+	return Try[A]{value: value, success: true}
 }
 
-// FailureOf returns a Failure[A] as Try[A].
+// FailureOf returns a failed Try[A] with the cause passed as parameter.
 func FailureOf[A any](cause error) Try[A] {
-	return Failure[A]{cause}
-}
-
-// Success is an implementation of Try with a defined value.
-type Success[A any] struct {
-	value A
+	return Try[A]{cause: cause}
 }
 
 // IsFailure checks if the current Try is a failure or not.
-// for the Success implementation the value return is false.
-func (s Success[A]) IsFailure() bool {
-	return false
+func (t Try[A]) IsFailure() bool {
+	return !t.success
 }
 
 // OrElse returns the Try value if success or the value passed as parameter if the Try is a failure.
-// for the Success implementation the defined value of the Try is returned.
-func (s Success[A]) OrElse(value A) A {
-	return s.value
+func (t Try[A]) OrElse(value A) A {
+	if !t.success {
+		return value
+	}
+	return t.value
 }
 
-// OrElseCause returns the Try cause if failure or the error cause passed as parameter if the Try is a success.
-// for the Success implementation the parameter cause is returned.
-func (s Success[A]) OrElseCause() (A, error) {
-	return s.value, nil
+// OrElseCause returns the Try value if success or the cause of the failure.
+// ErrEmptyTry is returned for a failure without cause.
+func (t Try[A]) OrElseCause() (A, error) {
+	if t.success {
+		return t.value, nil
+	}
+	if t.cause == nil {
+		return t.value, ErrEmptyTry
+	}
+	return t.value, t.cause
 }
 
 // Filter returns an Try containing the value if it matches the predicate or a failure Try.
-// for the Success implementation the current option is returned if the value passed the predicate otherwise an failure try is returned.
-func (s Success[A]) Filter(predicate func(A) bool, cause error) Try[A] {
+// a failed Try is returned unchanged if both parameters are defined.
+func (t Try[A]) Filter(predicate func(A) bool, cause error) Try[A] {
 	if predicate == nil {
 		return FailureOf[A](errors.New("predicate should not be nil"))
 	}
 	if cause == nil {
 		return FailureOf[A](errors.New("error cause should not be nil"))
 	}
-	if predicate(s.value) {
-		return s
+	if !t.success || predicate(t.value) {
+		return t
 	}
 	return FailureOf[A](cause)
 }
 
-// Failure is an implementation of Try with an error cause.
-type Failure[A any] struct {
-	cause error
-}
-
-// IsFailure checks if the current Try is a failure or not.
-// for the Failure implementation the value return is true.
-func (f Failure[A]) IsFailure() bool {
-	return true
-}
-
-// OrElse returns the Try value if success or the value passed as parameter if the Try is a failure.
-// for the Failure implementation the parameter value is returned.
-func (f Failure[A]) OrElse(value A) A {
-	return value
-}
-
-// OrElseCause returns the Try cause if failure or the error cause passed as parameter if the Try is a success.
-// for the Failure implementation the parameter cause is returned.
-func (f Failure[A]) OrElseCause() (A, error) {
-	return *new(A), f.cause
+// String returns a readable representation of the Try, "Success(value)" or "Failure(cause)".
+func (t Try[A]) String() string {
+	if t.success {
+		return fmt.Sprintf("Success(%v)", t.value)
+	}
+	_, cause := t.OrElseCause()
+	return fmt.Sprintf("Failure(%v)", cause)
 }
 
-// Filter returns an Try containing the value if it matches the predicate or a failure Try.
-// for the Failure implementation the current failure Try is returned.
-func (f Failure[A]) Filter(predicate func(A) bool, cause error) Try[A] {
-	if predicate == nil {
-		return FailureOf[A](errors.New("predicate should not be nil"))
+// GoString returns the Go syntax representation of the Try.
+// a failure without cause, such as the zero value, is represented as a composite literal.
+func (t Try[A]) GoString() string {
+	if t.success {
+		return fmt.Sprintf("control.SuccessOf[%s](%s)", gostring.TypeName[A](), gostring.Value(t.value))
 	}
-	if cause == nil {
-		return FailureOf[A](errors.New("error cause should not be nil"))
+	if t.cause == nil {
+		return fmt.Sprintf("control.Try[%s]{}", gostring.TypeName[A]())
 	}
-	return f
+	return fmt.Sprintf("control.FailureOf[%s](%s)", gostring.TypeName[A](), gostring.Value(t.cause))
 }
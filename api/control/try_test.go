@@ -2,20 +2,19 @@ package control
 
 import (
 	"errors"
+	"fmt"
 	"gotest.tools/v3/assert"
 	"strconv"
 	"testing"
 )
 
 var (
-	defaultTryError            = errors.New("default Try error")
-	nilCauseError              = errors.New("error cause should not be nil")
-	nilPredicateError          = errors.New("predicate should not be nil")
-	_                 Try[int] = Success[int]{10}
-	_                 Try[int] = Failure[int]{cause: defaultTryError}
-	success                    = SuccessOf[int](10)
-	failure                    = FailureOf[int](defaultTryError)
-	EvenPredicate              = func(value int) bool {
+	defaultTryError   = errors.New("default Try error")
+	nilCauseError     = errors.New("error cause should not be nil")
+	nilPredicateError = errors.New("predicate should not be nil")
+	success           = SuccessOf[int](10)
+	failure           = FailureOf[int](defaultTryError)
+	EvenPredicate     = func(value int) bool {
 		return value%2 == 0
 	}
 )
@@ -25,9 +24,20 @@ func TestIsFailure(t *testing.T) {
 	assert.Assert(t, failure.IsFailure(), "failure should not be a success")
 }
 
+func TestZeroTry(t *testing.T) {
+	var zero Try[int]
+	assert.Assert(t, zero.IsFailure(), "zero value should be a failure")
+	_, err := zero.OrElseCause()
+	assert.Equal(t, err, ErrEmptyTry)
+	assert.Equal(t, zero.String(), "Failure(empty Try without value nor cause)")
+}
+
 func TestTryOf(t *testing.T) {
 	assert.Assert(t, !TryOf(func() (int, error) { return 10, nil }).IsFailure(), "should not be a failure")
 	assert.Assert(t, TryOf(func() (int, error) { return 0, defaultTryError }).IsFailure(), "should not be a success")
+
+	_, err := TryOf(func() (int, error) { return 0, defaultTryError }).OrElseCause()
+	assert.Error(t, err, defaultTryError.Error(), "should keep the lambda error as cause")
 }
 
 func TestOrElse(t *testing.T) {
@@ -69,6 +79,9 @@ func TestTryMap(t *testing.T) {
 	}
 	assert.Assert(t, MapTry[int, string](failure, mapper).IsFailure(), "result of MapTry function should be a failure")
 	assert.Assert(t, !MapTry[int, string](success, mapper).IsFailure(), "result of MapTry function should be a success")
+
+	_, err := MapTry[int, string](failure, mapper).OrElseCause()
+	assert.Error(t, err, defaultTryError.Error(), "result of MapTry function should keep the failure cause")
 }
 
 func TestTryFlatMap(t *testing.T) {
@@ -77,4 +90,52 @@ func TestTryFlatMap(t *testing.T) {
 	}
 	assert.Assert(t, FlatMapTry[int, string](failure, mapper).IsFailure(), "result of MapTry function should be a failure")
 	assert.Assert(t, !FlatMapTry[int, string](success, mapper).IsFailure(), "result of MapTry function should be a success")
+
+	_, err := FlatMapTry[int, string](failure, mapper).OrElseCause()
+	assert.Error(t, err, defaultTryError.Error(), "result of FlatMapTry function should keep the failure cause")
+}
+
+func TestTryString(t *testing.T) {
+	assert.Equal(t, success.String(), "Success(10)")
+	assert.Equal(t, failure.String(), "Failure(default Try error)")
+}
+
+func TestTryGoString(t *testing.T) {
+	assert.Equal(t, fmt.Sprintf("%#v", success), "control.SuccessOf[int](10)")
+	assert.Equal(t, fmt.Sprintf("%#v", failure), `control.FailureOf[int](&errors.errorString{s:"default Try error"})`)
+	assert.Equal(t, fmt.Sprintf("%#v", Try[int]{}), "control.Try[int]{}")
+	assert.Equal(t, fmt.Sprintf("%#v", FailureOf[string](nil)), "control.Try[string]{}")
+}
+
+var trySink Try[int]
+
+func TestTryDoesNotAllocate(t *testing.T) {
+	allocations := testing.AllocsPerRun(100, func() {
+		trySink = MapTry(TryOf(func() (int, error) { return 1024, nil }), func(value int) int { return value + 1 })
+	})
+	assert.Equal(t, allocations, 0.0, "Try should not allocate")
+}
+
+func BenchmarkSuccessOf(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		trySink = SuccessOf(i + 1024)
+	}
+}
+
+func BenchmarkTryOf(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		trySink = TryOf(func() (int, error) { return i + 1024, nil })
+	}
+}
+
+func BenchmarkMapTry(b *testing.B) {
+	b.ReportAllocs()
+	var mapper = func(value int) int {
+		return value + 1
+	}
+	for i := 0; i < b.N; i++ {
+		trySink = MapTry(SuccessOf(i+1024), mapper)
+	}
 }
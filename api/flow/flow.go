@@ -0,0 +1,136 @@
+// Package flow provides asynchronous streams of elements with backpressure.
+package flow
+
+import (
+	"context"
+	"runtime/debug"
+
+	"glours/go2funk/api/collection"
+	"glours/go2funk/api/concurrent"
+	"glours/go2funk/api/control"
+)
+
+// Flow is a description of a stream of elements of type T, which is only run by a sink such as Fold, ForEach or ToList.
+// the elements are pushed downstream one at a time and a stage only produces its next element once the previous one
+// was accepted, so a slow sink slows down the sources instead of letting elements pile up, asynchronous stages only
+// hold the number of elements they are configured with.
+// a Flow stops at the first error, which is returned by the sink as a failure, and can be run several times.
+// the zero value of a Flow is an empty Flow.
+type Flow[T any] struct {
+	source func(ctx context.Context, emit func(T) bool) error
+}
+
+// FromChannel returns a Flow of the values received from the channel until it's closed.
+func FromChannel[T any](channel <-chan T) Flow[T] {
+	return Flow[T]{func(ctx context.Context, emit func(T) bool) error {
+		for {
+			select {
+			case <-ctx.Done():
+				return context.Cause(ctx)
+			case value, ok := <-channel:
+				if !ok || !emit(value) {
+					return nil
+				}
+			}
+		}
+	}}
+}
+
+// FromSlice returns a Flow of the elements of the slice.
+func FromSlice[T any](values []T) Flow[T] {
+	return Flow[T]{func(ctx context.Context, emit func(T) bool) error {
+		for _, value := range values {
+			if err := ctx.Err(); err != nil {
+				return context.Cause(ctx)
+			}
+			if !emit(value) {
+				return nil
+			}
+		}
+		return nil
+	}}
+}
+
+// FromList returns a Flow of the elements of the List.
+func FromList[T any](list collection.List[T]) Flow[T] {
+	return Flow[T]{func(ctx context.Context, emit func(T) bool) error {
+		var err error
+		list.ForEachWhile(func(value T) bool {
+			if err = ctx.Err(); err != nil {
+				err = context.Cause(ctx)
+				return false
+			}
+			return emit(value)
+		})
+		return err
+	}}
+}
+
+// Fold runs the Flow and combines its elements in order with the folder function, starting from zero.
+// it returns a failed Try with the cause of the error which terminated the Flow, or of the context if it's done before.
+func Fold[T, U any](ctx context.Context, flow Flow[T], zero U, folder func(U, T) U) control.Try[U] {
+	result := zero
+	if err := flow.run(ctx, func(value T) bool {
+		result = folder(result, value)
+		return true
+	}); err != nil {
+		return control.FailureOf[U](err)
+	}
+	return control.SuccessOf(result)
+}
+
+// ForEach runs the Flow and calls the function on each of its elements, it returns a Try with the number of elements.
+func (f Flow[T]) ForEach(ctx context.Context, consumer func(T)) control.Try[int] {
+	return Fold(ctx, f, 0, func(count int, value T) int {
+		consumer(value)
+		return count + 1
+	})
+}
+
+// ToList runs the Flow and returns a Try with a List of its elements.
+func (f Flow[T]) ToList(ctx context.Context) control.Try[collection.List[T]] {
+	var values []T
+	return control.MapTry(f.ForEach(ctx, func(value T) { values = append(values, value) }), func(int) collection.List[T] {
+		return collection.OfSlice(values)
+	})
+}
+
+// run is an internal function running the Flow with a context cancelled once it's over, turning a panic into an
+// error with a concurrent.PanicError cause.
+func (f Flow[T]) run(ctx context.Context, emit func(T) bool) (err error) {
+	if f.source == nil {
+		return nil
+	}
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	defer func() {
+		if value := recover(); value != nil {
+			err = &concurrent.PanicError{Value: value, Stack: debug.Stack()}
+		}
+	}()
+	return f.source(ctx, emit)
+}
+
+// produce is an internal function running the Flow in a new goroutine which sends its elements to a channel with the
+// capacity, closed once the Flow is over, and then sends the error which terminated it, if any, to the error channel.
+// the goroutine stops as soon as the context is done.
+func produce[T any](ctx context.Context, flow Flow[T], capacity int) (<-chan T, <-chan error) {
+	values := make(chan T, capacity)
+	errs := make(chan error, 1)
+	go func() {
+		defer close(errs)
+		err := flow.run(ctx, func(value T) bool {
+			select {
+			case values <- value:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+		close(values)
+		if err != nil {
+			errs <- err
+		}
+	}()
+	return values, errs
+}
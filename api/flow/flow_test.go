@@ -0,0 +1,121 @@
+package flow
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"glours/go2funk/api/collection"
+	"glours/go2funk/api/concurrent"
+	"glours/go2funk/api/control"
+	"gotest.tools/v3/assert"
+)
+
+var errBoom = errors.New("boom")
+
+func background(t *testing.T) context.Context {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+func channelOf[T any](values ...T) <-chan T {
+	channel := make(chan T, len(values))
+	for _, value := range values {
+		channel <- value
+	}
+	close(channel)
+	return channel
+}
+
+// endless returns a channel receiving increasing numbers until the end of the test.
+func endless(t *testing.T) <-chan int {
+	channel := make(chan int)
+	done := make(chan struct{})
+	t.Cleanup(func() { close(done) })
+	go func() {
+		for i := 0; ; i++ {
+			select {
+			case channel <- i:
+			case <-done:
+				return
+			}
+		}
+	}()
+	return channel
+}
+
+func assertList[T any](t *testing.T, result control.Try[collection.List[T]], expected collection.List[T]) {
+	t.Helper()
+	list, err := result.OrElseCause()
+	assert.NilError(t, err)
+	assert.Assert(t, list.Equals(expected), "expected %v but value is %v", expected, list)
+}
+
+func TestSources(t *testing.T) {
+	testCases := []struct {
+		name string
+		flow Flow[int]
+	}{
+		{name: "FromSlice", flow: FromSlice([]int{1, 2, 3})},
+		{name: "FromList", flow: FromList(collection.OfSlice([]int{1, 2, 3}))},
+		{name: "FromChannel", flow: FromChannel(channelOf(1, 2, 3))},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assertList(t, testCase.flow.ToList(background(t)), collection.OfSlice([]int{1, 2, 3}))
+		})
+	}
+}
+
+func TestSinks(t *testing.T) {
+	flow := FromSlice([]int{1, 2, 3, 4})
+	assert.Equal(t, Fold(background(t), flow, 0, func(sum int, value int) int { return sum + value }), control.SuccessOf(10))
+
+	var visited []int
+	assert.Equal(t, flow.ForEach(background(t), func(value int) { visited = append(visited, value) }), control.SuccessOf(4))
+	assert.DeepEqual(t, visited, []int{1, 2, 3, 4})
+
+	assertList(t, flow.ToList(background(t)), collection.OfSlice([]int{1, 2, 3, 4}))
+	assertList(t, flow.ToList(background(t)), collection.OfSlice([]int{1, 2, 3, 4}))
+	assertList(t, Flow[int]{}.ToList(background(t)), collection.Empty[int]())
+}
+
+func TestFlowFailures(t *testing.T) {
+	_, err := Map(FromSlice([]int{1, 2}), func(value int) int {
+		panic("unexpected value")
+	}).ToList(background(t)).OrElseCause()
+	var panicError *concurrent.PanicError
+	assert.Assert(t, errors.As(err, &panicError))
+
+	_, err = Map(FromSlice([]int{1, 2}).Buffer(1), func(value int) int {
+		panic("unexpected value")
+	}).Buffer(1).ToList(background(t)).OrElseCause()
+	assert.Assert(t, errors.As(err, &panicError), "a panic in an asynchronous stage should fail the Flow")
+
+	_, err = MapAsync(FromSlice([]int{1, 2}), 2, func(context.Context, int) (int, error) {
+		panic("unexpected value")
+	}).ToList(background(t)).OrElseCause()
+	assert.Assert(t, errors.As(err, &panicError), "a panic in MapAsync should fail the Flow")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = FromChannel(make(chan int)).ToList(ctx).OrElseCause()
+	assert.Assert(t, errors.Is(err, context.Canceled))
+
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = FromChannel(make(chan int)).Buffer(2).ForEach(ctx, func(int) {}).OrElseCause()
+	assert.Assert(t, errors.Is(err, context.DeadlineExceeded))
+
+	source := endless(t)
+	identity := func(value int) int { return value }
+	for i := 0; i < 50; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Millisecond)
+		_, err = GroupedWithin(Map(FromChannel(source), identity), 1000, time.Hour).ToList(ctx).OrElseCause()
+		cancel()
+		assert.Assert(t, errors.Is(err, context.DeadlineExceeded), "a cancelled GroupedWithin should fail, got %v", err)
+	}
+}
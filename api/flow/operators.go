@@ -0,0 +1,275 @@
+package flow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"glours/go2funk/api"
+	"glours/go2funk/api/collection"
+	"glours/go2funk/api/concurrent"
+)
+
+// errStopped is the cause of the cancellation of the upstream stages once the downstream ones don't accept elements anymore.
+var errStopped = errors.New("flow: stopped by downstream")
+
+// Map returns a Flow mapping the elements of the Flow[T] to elements of a new type U preserving their order.
+func Map[T, U any](flow Flow[T], mapper func(T) U) Flow[U] {
+	return Flow[U]{func(ctx context.Context, emit func(U) bool) error {
+		return flow.run(ctx, func(value T) bool {
+			return emit(mapper(value))
+		})
+	}}
+}
+
+// MapAsync returns a Flow mapping the elements of the Flow[T] with the function, running at most parallelism of them
+// at the same time and preserving their order.
+// the Flow stops with the error of the first failing call, the context passed to the function is then cancelled.
+func MapAsync[T, U any](flow Flow[T], parallelism int, mapper func(context.Context, T) (U, error)) Flow[U] {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	return Flow[U]{func(ctx context.Context, emit func(U) bool) error {
+		ctx, cancel := context.WithCancelCause(ctx)
+		defer cancel(nil)
+		slots := make(chan struct{}, parallelism)
+		futures, errs := produce(ctx, Map(flow, func(value T) concurrent.Future[U] {
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				return concurrent.FailedFuture[U](context.Cause(ctx))
+			}
+			return concurrent.Async(ctx, func() (U, error) {
+				return mapper(ctx, value)
+			})
+		}), parallelism)
+		return consume(ctx, cancel, futures, errs, func(future concurrent.Future[U]) (bool, error) {
+			value, err := future.Await(ctx).OrElseCause()
+			<-slots
+			if err != nil {
+				return false, err
+			}
+			return emit(value), nil
+		})
+	}}
+}
+
+// Filter returns a Flow containing only the elements which are validating the predicate passed as parameter.
+func (f Flow[T]) Filter(predicate func(T) bool) Flow[T] {
+	return Flow[T]{func(ctx context.Context, emit func(T) bool) error {
+		return f.run(ctx, func(value T) bool {
+			return !predicate(value) || emit(value)
+		})
+	}}
+}
+
+// Buffer returns a Flow running the current one in its own goroutine, which can produce up to size elements ahead of
+// the downstream stages.
+func (f Flow[T]) Buffer(size int) Flow[T] {
+	return Flow[T]{func(ctx context.Context, emit func(T) bool) error {
+		ctx, cancel := context.WithCancelCause(ctx)
+		defer cancel(nil)
+		values, errs := produce(ctx, f, size)
+		return consume(ctx, cancel, values, errs, func(value T) (bool, error) {
+			return emit(value), nil
+		})
+	}}
+}
+
+// Throttle returns a Flow emitting at most elements elements per period, evenly spaced, slowing down the upstream stages.
+func (f Flow[T]) Throttle(elements int, per time.Duration) Flow[T] {
+	if elements < 1 {
+		elements = 1
+	}
+	interval := per / time.Duration(elements)
+	return Flow[T]{func(ctx context.Context, emit func(T) bool) error {
+		var next time.Time
+		var cause error
+		err := f.run(ctx, func(value T) bool {
+			if wait := time.Until(next); wait > 0 {
+				timer := time.NewTimer(wait)
+				defer timer.Stop()
+				select {
+				case <-timer.C:
+				case <-ctx.Done():
+					cause = context.Cause(ctx)
+					return false
+				}
+			}
+			next = time.Now().Add(interval)
+			return emit(value)
+		})
+		if err == nil {
+			err = cause
+		}
+		return err
+	}}
+}
+
+// Merge returns a Flow of the elements of all the Flows passed as parameter, in the order they are produced.
+// the Flow stops with the first error of any of them.
+func Merge[T any](flows ...Flow[T]) Flow[T] {
+	return Flow[T]{func(ctx context.Context, emit func(T) bool) error {
+		ctx, cancel := context.WithCancelCause(ctx)
+		defer cancel(nil)
+		values := make(chan T)
+		errs := make(chan error, len(flows))
+		var running sync.WaitGroup
+		for _, flow := range flows {
+			running.Add(1)
+			go func(flow Flow[T]) {
+				defer running.Done()
+				if err := flow.run(ctx, func(value T) bool {
+					select {
+					case values <- value:
+						return true
+					case <-ctx.Done():
+						return false
+					}
+				}); err != nil {
+					errs <- err
+					cancel(err)
+				}
+			}(flow)
+		}
+		go func() {
+			running.Wait()
+			close(values)
+			close(errs)
+		}()
+		return consume(ctx, cancel, values, errs, func(value T) (bool, error) {
+			return emit(value), nil
+		})
+	}}
+}
+
+// Zip returns a Flow of Pairs combining the elements of both Flows in order, it ends with the shortest of them.
+func Zip[L, R any](left Flow[L], right Flow[R]) Flow[api.Pair[L, R]] {
+	return Flow[api.Pair[L, R]]{func(ctx context.Context, emit func(api.Pair[L, R]) bool) error {
+		ctx, cancel := context.WithCancelCause(ctx)
+		defer cancel(nil)
+		lefts, leftErrs := produce(ctx, left, 0)
+		rights, rightErrs := produce(ctx, right, 0)
+		err := consume(ctx, cancel, lefts, leftErrs, func(value L) (bool, error) {
+			other, ok := <-rights
+			if !ok {
+				// the right Flow may have been interrupted by the context rather than be over
+				return false, causeOf(ctx, nil)
+			}
+			return emit(api.NewPair(value, other)), nil
+		})
+		cancel(errStopped)
+		for range rights {
+		}
+		if rightErr := ignoreStopped(<-rightErrs); err == nil {
+			err = rightErr
+		}
+		return err
+	}}
+}
+
+// GroupedWithin returns a Flow of Lists of at most n elements of the Flow[T], a List being emitted once it contains n
+// elements or once the duration elapsed since its first element, whichever comes first.
+// the Flow fails if n is less than or equal to 0.
+func GroupedWithin[T any](flow Flow[T], n int, duration time.Duration) Flow[collection.List[T]] {
+	return Flow[collection.List[T]]{func(ctx context.Context, emit func(collection.List[T]) bool) error {
+		if n <= 0 {
+			return fmt.Errorf("flow: invalid group size %d", n)
+		}
+		ctx, cancel := context.WithCancelCause(ctx)
+		defer cancel(nil)
+		values, errs := produce(ctx, flow, 0)
+		var group []T
+		timer := time.NewTimer(duration)
+		stopTimer(timer)
+		defer timer.Stop()
+		flush := func() bool {
+			stopTimer(timer)
+			if len(group) == 0 {
+				return true
+			}
+			full := collection.OfSlice(group)
+			group = group[:0]
+			return emit(full)
+		}
+		for {
+			select {
+			case value, ok := <-values:
+				if !ok {
+					if err := causeOf(ctx, ignoreStopped(<-errs)); err != nil {
+						return err
+					}
+					flush()
+					return nil
+				}
+				if group = append(group, value); len(group) == 1 {
+					timer.Reset(duration)
+				}
+				if len(group) >= n && !flush() {
+					return stop(cancel, values, errs)
+				}
+			case <-timer.C:
+				if !flush() {
+					return stop(cancel, values, errs)
+				}
+			case <-ctx.Done():
+				return causeOf(ctx, stop(cancel, values, errs))
+			}
+		}
+	}}
+}
+
+// stopTimer is an internal function stopping the timer and discarding its expiration if it was not received yet.
+func stopTimer(timer *time.Timer) {
+	if !timer.Stop() {
+		select {
+		case <-timer.C:
+		default:
+		}
+	}
+}
+
+// consume is an internal function handling the values sent by a producer until the channel is closed or the handler
+// stops, and returning the error which terminated the producer or the handler, if any.
+func consume[T any](ctx context.Context, cancel context.CancelCauseFunc, values <-chan T, errs <-chan error, handle func(T) (bool, error)) error {
+	for value := range values {
+		accepted, err := handle(value)
+		if err != nil {
+			cancel(err)
+			_ = stop(cancel, values, errs)
+			return err
+		}
+		if !accepted {
+			return stop(cancel, values, errs)
+		}
+	}
+	return causeOf(ctx, ignoreStopped(<-errs))
+}
+
+// stop is an internal function cancelling a producer, waiting for its end, and returning its error unless it was
+// cancelled because the downstream stages stopped.
+func stop[T any](cancel context.CancelCauseFunc, values <-chan T, errs <-chan error) error {
+	cancel(errStopped)
+	for range values {
+	}
+	return ignoreStopped(<-errs)
+}
+
+// causeOf is an internal function returning the error passed as parameter, or the cause of the context if the error
+// is nil and the context is done, so a Flow interrupted by the context doesn't end as if it was over.
+func causeOf(ctx context.Context, err error) error {
+	if err == nil && ctx.Err() != nil {
+		return ignoreStopped(context.Cause(ctx))
+	}
+	return err
+}
+
+// ignoreStopped is an internal function discarding the error of a stage cancelled because the downstream stages stopped.
+func ignoreStopped(err error) error {
+	if errors.Is(err, errStopped) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,173 @@
+package flow
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"glours/go2funk/api"
+	"glours/go2funk/api/collection"
+	"gotest.tools/v3/assert"
+)
+
+func TestOperators(t *testing.T) {
+	numbers := FromSlice([]int{1, 2, 3, 4, 5, 6})
+	testCases := []struct {
+		name     string
+		flow     Flow[int]
+		expected collection.List[int]
+	}{
+		{name: "Map", flow: Map(numbers, func(value int) int { return value * 10 }), expected: collection.OfSlice([]int{10, 20, 30, 40, 50, 60})},
+		{name: "Filter", flow: numbers.Filter(func(value int) bool { return value%2 == 0 }), expected: collection.OfSlice([]int{2, 4, 6})},
+		{name: "Buffer", flow: numbers.Buffer(2), expected: collection.OfSlice([]int{1, 2, 3, 4, 5, 6})},
+		{name: "Throttle", flow: numbers.Throttle(1000, time.Second), expected: collection.OfSlice([]int{1, 2, 3, 4, 5, 6})},
+		{
+			name: "MapAsync keeps the order",
+			flow: MapAsync(numbers, 3, func(_ context.Context, value int) (int, error) {
+				time.Sleep(time.Duration(6-value) * time.Millisecond)
+				return value * value, nil
+			}),
+			expected: collection.OfSlice([]int{1, 4, 9, 16, 25, 36}),
+		},
+		{
+			name:     "Merge",
+			flow:     sumOf(Merge(FromSlice([]int{1, 2}), FromList(collection.OfSlice([]int{3, 4})), Flow[int]{})),
+			expected: collection.Of(10),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assertList(t, testCase.flow.ToList(background(t)), testCase.expected)
+		})
+	}
+}
+
+// sumOf returns a single element Flow with the sum of the elements of the Flow, used to check the unordered Merge.
+func sumOf(flow Flow[int]) Flow[int] {
+	return Flow[int]{func(ctx context.Context, emit func(int) bool) error {
+		sum, err := Fold(ctx, flow, 0, func(sum int, value int) int { return sum + value }).OrElseCause()
+		if err != nil {
+			return err
+		}
+		emit(sum)
+		return nil
+	}}
+}
+
+func TestMapAsync(t *testing.T) {
+	var running, maxRunning atomic.Int32
+	result := MapAsync(FromSlice(make([]int, 20)), 4, func(_ context.Context, value int) (int, error) {
+		current := running.Add(1)
+		defer running.Add(-1)
+		for {
+			max := maxRunning.Load()
+			if current <= max || maxRunning.CompareAndSwap(max, current) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		return value, nil
+	}).ForEach(background(t), func(int) {})
+	count, err := result.OrElseCause()
+	assert.NilError(t, err)
+	assert.Equal(t, count, 20)
+	assert.Assert(t, maxRunning.Load() <= 4, "at most 4 calls should run at the same time, got %d", maxRunning.Load())
+
+	_, err = MapAsync(FromSlice([]int{1, 2, 3}), 3, func(ctx context.Context, value int) (int, error) {
+		if value == 2 {
+			return 0, errBoom
+		}
+		if value == 3 {
+			<-ctx.Done()
+		}
+		return value, nil
+	}).ToList(background(t)).OrElseCause()
+	assert.Equal(t, err, errBoom)
+}
+
+func TestBackpressure(t *testing.T) {
+	var produced atomic.Int32
+	source := make(chan int)
+	go func() {
+		defer close(source)
+		for i := 0; i < 50; i++ {
+			source <- i
+			produced.Add(1)
+		}
+	}()
+	consumed := 0
+	count, err := Map(FromChannel(source), func(value int) int { return value }).Buffer(4).ForEach(background(t), func(int) {
+		consumed++
+		time.Sleep(time.Millisecond)
+		assert.Assert(t, int(produced.Load())-consumed <= 8, "a slow sink should not let elements pile up, %d produced for %d consumed", produced.Load(), consumed)
+	}).OrElseCause()
+	assert.NilError(t, err)
+	assert.Equal(t, count, 50)
+}
+
+func TestThrottle(t *testing.T) {
+	start := time.Now()
+	count, err := FromSlice(make([]int, 5)).Throttle(100, time.Second).ForEach(background(t), func(int) {}).OrElseCause()
+	assert.NilError(t, err)
+	assert.Equal(t, count, 5)
+	assert.Assert(t, time.Since(start) >= 40*time.Millisecond, "5 elements at 100 per second should take at least 40ms, took %v", time.Since(start))
+}
+
+func TestMergeFailure(t *testing.T) {
+	failing := MapAsync(FromSlice([]int{1}), 1, func(context.Context, int) (int, error) { return 0, errBoom })
+	_, err := Merge(FromChannel(make(chan int)), failing).ToList(background(t)).OrElseCause()
+	assert.Equal(t, err, errBoom)
+}
+
+func TestZip(t *testing.T) {
+	zipped := Zip(FromSlice([]int{1, 2, 3}), FromList(collection.OfSlice([]string{"one", "two"})))
+	assertList(t, zipped.ToList(background(t)), collection.OfSlice([]api.Pair[int, string]{api.NewPair(1, "one"), api.NewPair(2, "two")}))
+
+	infinite := Flow[int]{func(ctx context.Context, emit func(int) bool) error {
+		for i := 0; emit(i); i++ {
+		}
+		return nil
+	}}
+	assertList(t, Zip(infinite, FromSlice([]string{"a"})).ToList(background(t)), collection.Of(api.NewPair(0, "a")))
+
+	failing := MapAsync(FromSlice([]int{1}), 1, func(context.Context, int) (int, error) { return 0, errBoom })
+	_, err := Zip(infinite, failing).ToList(background(t)).OrElseCause()
+	assert.Assert(t, errors.Is(err, errBoom))
+
+	lefts, rights := endless(t), endless(t)
+	for i := 0; i < 50; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Millisecond)
+		_, err = Zip(FromChannel(lefts), FromChannel(rights)).ToList(ctx).OrElseCause()
+		cancel()
+		assert.Assert(t, errors.Is(err, context.DeadlineExceeded), "a cancelled Zip should fail, got %v", err)
+	}
+}
+
+func TestGroupedWithin(t *testing.T) {
+	bySize := GroupedWithin(FromSlice([]int{1, 2, 3, 4, 5}), 2, time.Hour)
+	assertList(t, bySize.ToList(background(t)), collection.OfSlice([]collection.List[int]{
+		collection.OfSlice([]int{1, 2}), collection.OfSlice([]int{3, 4}), collection.Of(5),
+	}))
+
+	source := make(chan int)
+	go func() {
+		defer close(source)
+		source <- 1
+		source <- 2
+		time.Sleep(100 * time.Millisecond)
+		source <- 3
+	}()
+	byTime := GroupedWithin(FromChannel(source), 10, 20*time.Millisecond)
+	assertList(t, byTime.ToList(background(t)), collection.OfSlice([]collection.List[int]{
+		collection.OfSlice([]int{1, 2}), collection.Of(3),
+	}))
+
+	failing := MapAsync(FromSlice([]int{1}), 1, func(context.Context, int) (int, error) { return 0, errBoom })
+	_, err := GroupedWithin(failing, 2, time.Hour).ToList(background(t)).OrElseCause()
+	assert.Equal(t, err, errBoom)
+
+	_, err = GroupedWithin(FromSlice([]int{1}), 0, time.Hour).ToList(background(t)).OrElseCause()
+	assert.Error(t, err, "flow: invalid group size 0")
+}
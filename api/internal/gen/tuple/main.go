@@ -0,0 +1,146 @@
+// Command tuple generates the TupleN product types of the api package.
+//
+// It is invoked through go generate from the api package directory and writes tuple_gen.go.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+)
+
+const (
+	minArity = 3
+	maxArity = 8
+	output   = "tuple_gen.go"
+)
+
+// tuple describes the TupleN type to generate.
+type tuple struct {
+	Arity int
+	Slots []int
+}
+
+// Name returns the name of the tuple type.
+func (t tuple) Name() string {
+	return fmt.Sprintf("Tuple%d", t.Arity)
+}
+
+// join formats each slot of the tuple with the format passed as parameter and joins them with a comma.
+func (t tuple) join(format string) string {
+	parts := make([]string, len(t.Slots))
+	for i, slot := range t.Slots {
+		parts[i] = strings.ReplaceAll(format, "#", fmt.Sprint(slot))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// TypeParams returns the type parameters of the tuple, e.g. "T1, T2, T3".
+func (t tuple) TypeParams() string {
+	return t.join("T#")
+}
+
+// MappedTypeParams returns the type parameters of the tuple replacing the slot passed as parameter by U.
+func (t tuple) MappedTypeParams(slot int) string {
+	params := strings.Split(t.TypeParams(), ", ")
+	params[slot-1] = "U"
+	return strings.Join(params, ", ")
+}
+
+// MappedValues returns the values of a tuple named tuple applying mapper on the slot passed as parameter.
+func (t tuple) MappedValues(slot int) string {
+	values := strings.Split(t.join("tuple.v#"), ", ")
+	values[slot-1] = fmt.Sprintf("mapper(%s)", values[slot-1])
+	return strings.Join(values, ", ")
+}
+
+// NestedPairs returns the type of the right nested pairs matching the tuple, e.g. "Pair[T1, Pair[T2, T3]]".
+func (t tuple) NestedPairs() string {
+	nested := fmt.Sprintf("T%d", t.Arity)
+	for slot := t.Arity - 1; slot >= 1; slot-- {
+		nested = fmt.Sprintf("Pair[T%d, %s]", slot, nested)
+	}
+	return nested
+}
+
+// NestedPairsAccess returns the expression reading the slot passed as parameter from right nested pairs named pairs.
+func (t tuple) NestedPairsAccess(slot int) string {
+	access := "pairs"
+	for i := 1; i < slot; i++ {
+		access += ".right"
+	}
+	if slot < t.Arity {
+		access += ".left"
+	}
+	return access
+}
+
+var funcs = template.FuncMap{
+	"join": func(t tuple, format string) string { return t.join(format) },
+}
+
+var tupleTemplate = template.Must(template.New("tuple").Funcs(funcs).Parse(`// Code generated by go run ./internal/gen/tuple; DO NOT EDIT.
+
+package api
+{{range .}}{{$t := .}}
+// {{.Name}} is a product type containing {{.Arity}} values of possibly different types.
+type {{.Name}}[{{.TypeParams}} any] struct {
+{{- range .Slots}}
+	v{{.}} T{{.}}
+{{- end}}
+}
+
+// New{{.Name}} returns a {{.Name}} containing the values passed as parameter.
+func New{{.Name}}[{{.TypeParams}} any]({{join . "v# T#"}}) {{.Name}}[{{.TypeParams}}] {
+	return {{.Name}}[{{.TypeParams}}]{ {{- join . "v#"}}}
+}
+
+// {{.Name}}OfPairs returns a {{.Name}} containing the values of right nested pairs.
+func {{.Name}}OfPairs[{{.TypeParams}} any](pairs {{.NestedPairs}}) {{.Name}}[{{.TypeParams}}] {
+	return {{.Name}}[{{.TypeParams}}]{
+{{- range .Slots}}{{$t.NestedPairsAccess .}}, {{end -}}
+	}
+}
+
+// Map{{.Name}} maps each value of a {{.Name}} to a new {{.Name}} with the mappers passed as parameter.
+func Map{{.Name}}[{{.TypeParams}}, {{join . "U#"}} any](tuple {{.Name}}[{{.TypeParams}}], {{join . "mapper# func(T#) U#"}}) {{.Name}}[{{join . "U#"}}] {
+	return {{.Name}}[{{join . "U#"}}]{ {{- join . "mapper#(tuple.v#)"}}}
+}
+{{range .Slots}}
+// Map{{.}}{{$t.Name}} maps the value at position {{.}} of a {{$t.Name}} with the mapper passed as parameter.
+func Map{{.}}{{$t.Name}}[{{$t.TypeParams}}, U any](tuple {{$t.Name}}[{{$t.TypeParams}}], mapper func(T{{.}}) U) {{$t.Name}}[{{$t.MappedTypeParams .}}] {
+	return {{$t.Name}}[{{$t.MappedTypeParams .}}]{ {{- $t.MappedValues .}}}
+}
+{{end}}{{range .Slots}}
+// Get{{.}} returns the value at position {{.}} of the {{$t.Name}}.
+func (t {{$t.Name}}[{{$t.TypeParams}}]) Get{{.}}() T{{.}} {
+	return t.v{{.}}
+}
+{{end}}{{end}}`))
+
+func main() {
+	var tuples []tuple
+	for arity := minArity; arity <= maxArity; arity++ {
+		t := tuple{Arity: arity}
+		for slot := 1; slot <= arity; slot++ {
+			t.Slots = append(t.Slots, slot)
+		}
+		tuples = append(tuples, t)
+	}
+
+	var buffer bytes.Buffer
+	if err := tupleTemplate.Execute(&buffer, tuples); err != nil {
+		log.Fatalf("failed to execute tuple template: %v", err)
+	}
+	source, err := format.Source(buffer.Bytes())
+	if err != nil {
+		log.Fatalf("failed to format generated tuples: %v\n%s", err, buffer.String())
+	}
+	if err := os.WriteFile(output, source, 0o644); err != nil {
+		log.Fatalf("failed to write %s: %v", output, err)
+	}
+}
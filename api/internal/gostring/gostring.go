@@ -0,0 +1,28 @@
+// Package gostring provides helpers shared by the GoString implementations of the api packages.
+package gostring
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// TypeName returns the name of the type T as it would be written in Go source, e.g. "int" or "[]string".
+// the package path of named types is shortened to the package name.
+func TypeName[T any]() string {
+	name := reflect.TypeOf((*T)(nil)).Elem().String()
+	// type arguments of generic types are printed with their full package path
+	for {
+		slash := strings.LastIndex(name, "/")
+		if slash < 0 {
+			return name
+		}
+		start := strings.LastIndexAny(name[:slash], "[], *") + 1
+		name = name[:start] + name[slash+1:]
+	}
+}
+
+// Value returns the Go syntax representation of the value.
+func Value(value any) string {
+	return fmt.Sprintf("%#v", value)
+}
@@ -0,0 +1,20 @@
+package gostring
+
+import (
+	"glours/go2funk/api"
+	"gotest.tools/v3/assert"
+	"testing"
+)
+
+func TestTypeName(t *testing.T) {
+	assert.Equal(t, TypeName[int](), "int")
+	assert.Equal(t, TypeName[error](), "error")
+	assert.Equal(t, TypeName[[]string](), "[]string")
+	assert.Equal(t, TypeName[api.Pair[int, string]](), "api.Pair[int,string]")
+	assert.Equal(t, TypeName[map[string]api.Pair[int, *api.Pair[int, int]]](), "map[string]api.Pair[int,*api.Pair[int,int]]")
+}
+
+func TestValue(t *testing.T) {
+	assert.Equal(t, Value("ten"), `"ten"`)
+	assert.Equal(t, Value(10), "10")
+}
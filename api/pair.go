@@ -1,5 +1,14 @@
 package api
 
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/mitchellh/hashstructure/v2"
+)
+
+// Pair is a product type containing a "left" and a "right" value of possibly different types.
 type Pair[L, R any] struct {
 	left  L
 	right R
@@ -13,6 +22,20 @@ func MapPair[L, R, T, U any](pair Pair[L, R], mapperLeft func(L) T, mapperRight
 	return Pair[T, U]{ mapperLeft(pair.left), mapperRight(pair.right)}
 }
 
+// FoldPair combines the "left" and "right" values of a Pair[L,R] into a single value of type T.
+func FoldPair[L, R, T any](pair Pair[L, R], folder func(L, R) T) T {
+	return folder(pair.left, pair.right)
+}
+
+// ComparePair compares two pairs lexicographically, first on their "left" values then on their "right" values.
+// the result is -1 if a is less than b, 0 if a equals b and +1 if a is greater than b.
+func ComparePair[L, R Ordered](a Pair[L, R], b Pair[L, R]) int {
+	if result := compare(a.left, b.left); result != 0 {
+		return result
+	}
+	return compare(a.right, b.right)
+}
+
 func MapLeftPair[L, R, U any](pair Pair[L, R], mapper func(L) U) Pair[U, R] {
 	return Pair[U, R]{mapper(pair.left), pair.right}
 }
@@ -27,4 +50,59 @@ func (p Pair[L, R]) GetLeft() L {
 
 func (p Pair[L, R]) GetRight() R {
 	return p.right
-}
\ No newline at end of file
+}
+
+// Swap returns a new Pair with the "left" and "right" values exchanged.
+func (p Pair[L, R]) Swap() Pair[R, L] {
+	return Pair[R, L]{p.right, p.left}
+}
+
+// Equals checks if the other Pair passed as parameter contains the same values as the current one.
+func (p Pair[L, R]) Equals(other Pair[L, R]) bool {
+	return reflect.DeepEqual(p.left, other.left) && reflect.DeepEqual(p.right, other.right)
+}
+
+// HashCode returns a hash of the values of the current Pair.
+func (p Pair[L, R]) HashCode() (uint64, error) {
+	return hashstructure.Hash(struct {
+		Left  L
+		Right R
+	}{p.left, p.right}, hashstructure.FormatV2, nil)
+}
+
+// String returns a representation of the Pair as "(left, right)".
+func (p Pair[L, R]) String() string {
+	return fmt.Sprintf("(%v, %v)", p.left, p.right)
+}
+
+// MarshalJSON encodes the Pair as a two-element JSON array.
+func (p Pair[L, R]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{p.left, p.right})
+}
+
+// UnmarshalJSON decodes a two-element JSON array into the Pair.
+func (p *Pair[L, R]) UnmarshalJSON(data []byte) error {
+	var elements []json.RawMessage
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	if len(elements) != 2 {
+		return fmt.Errorf("a Pair should be encoded as a two-element array but has %d elements", len(elements))
+	}
+	if err := json.Unmarshal(elements[0], &p.left); err != nil {
+		return err
+	}
+	return json.Unmarshal(elements[1], &p.right)
+}
+
+// compare is an internal function comparing two ordered values.
+func compare[T Ordered](a T, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
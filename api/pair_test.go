@@ -1,6 +1,7 @@
 package api
 
 import (
+	"encoding/json"
 	"fmt"
 	"gotest.tools/v3/assert"
 	"strconv"
@@ -42,3 +43,79 @@ func TestMapPair(t *testing.T) {
 
 	assert.Equal(t, string(mapped.GetRight()), "ten", fmt.Sprintf("value should be a []array representing 'ten' string but is %s", mapped.GetRight()))
 }
+
+func TestSwap(t *testing.T) {
+	swapped := pair.Swap()
+	assert.Equal(t, swapped, NewPair("ten", 10), fmt.Sprintf("value should be (ten, 10) but is %s", swapped))
+}
+
+func TestFoldPair(t *testing.T) {
+	folded := FoldPair(pair, func(left int, right string) string {
+		return strconv.Itoa(left) + "=" + right
+	})
+	assert.Equal(t, folded, "10=ten", fmt.Sprintf("value should be '10=ten' but is '%s'", folded))
+}
+
+func TestPairEquals(t *testing.T) {
+	assert.Assert(t, pair.Equals(NewPair(10, "ten")), "pairs should be equal")
+	assert.Assert(t, !pair.Equals(NewPair(10, "eleven")), "pairs should not be equal")
+	assert.Assert(t, NewPair(1, []int{1, 2}).Equals(NewPair(1, []int{1, 2})), "pairs of slices should be equal")
+}
+
+func TestPairHashCode(t *testing.T) {
+	hash, err := pair.HashCode()
+	assert.NilError(t, err)
+	sameHash, err := NewPair(10, "ten").HashCode()
+	assert.NilError(t, err)
+	otherHash, err := NewPair(10, "eleven").HashCode()
+	assert.NilError(t, err)
+
+	assert.Equal(t, hash, sameHash, "equal pairs should have the same hash")
+	assert.Assert(t, hash != otherHash, "different pairs should have different hashes")
+}
+
+func TestComparePair(t *testing.T) {
+	testCases := []struct {
+		name     string
+		a        Pair[int, string]
+		b        Pair[int, string]
+		expected int
+	}{
+		{name: "Equal pairs", a: NewPair(1, "a"), b: NewPair(1, "a"), expected: 0},
+		{name: "Lower left value", a: NewPair(1, "b"), b: NewPair(2, "a"), expected: -1},
+		{name: "Greater left value", a: NewPair(3, "a"), b: NewPair(2, "b"), expected: 1},
+		{name: "Lower right value", a: NewPair(1, "a"), b: NewPair(1, "b"), expected: -1},
+		{name: "Greater right value", a: NewPair(1, "c"), b: NewPair(1, "b"), expected: 1},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := ComparePair(testCase.a, testCase.b)
+			assert.Equal(t, result, testCase.expected, fmt.Sprintf("expected %d but value is %d", testCase.expected, result))
+		})
+	}
+}
+
+func TestPairString(t *testing.T) {
+	assert.Equal(t, pair.String(), "(10, ten)")
+	assert.Equal(t, fmt.Sprint(NewPair(NewPair(1, 2), 3.5)), "((1, 2), 3.5)")
+}
+
+func TestPairJSON(t *testing.T) {
+	data, err := json.Marshal(pair)
+	assert.NilError(t, err)
+	assert.Equal(t, string(data), `[10,"ten"]`)
+
+	var decoded Pair[int, string]
+	assert.NilError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, decoded, pair)
+
+	assert.Error(t, json.Unmarshal([]byte(`[10]`), &decoded), "a Pair should be encoded as a two-element array but has 1 elements")
+	assert.ErrorContains(t, json.Unmarshal([]byte(`["10","ten"]`), &decoded), "cannot unmarshal string")
+}
+
+func TestPairAsMapKey(t *testing.T) {
+	counts := map[Pair[int, string]]int{}
+	counts[NewPair(10, "ten")]++
+	counts[pair]++
+	assert.Equal(t, counts[pair], 2)
+}
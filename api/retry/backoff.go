@@ -0,0 +1,53 @@
+package retry
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// Backoff returns the delay to wait after the failed attempt passed as parameter, attempts being numbered from 1.
+type Backoff func(attempt int) time.Duration
+
+// Constant returns a Backoff waiting the same delay after each attempt.
+func Constant(delay time.Duration) Backoff {
+	return func(int) time.Duration {
+		return delay
+	}
+}
+
+// Exponential returns a Backoff waiting the initial delay after the first attempt and multiplying it by the factor
+// after each following one, without exceeding the maximum delay if it's greater than 0.
+func Exponential(initial time.Duration, factor float64, maximum time.Duration) Backoff {
+	return func(attempt int) time.Duration {
+		delay := float64(initial) * math.Pow(factor, float64(attempt-1))
+		// float64(math.MaxInt64) is 2^63, which already overflows a Duration
+		if maximum > 0 && delay > float64(maximum) || delay >= math.MaxInt64 {
+			if maximum > 0 {
+				return maximum
+			}
+			return time.Duration(math.MaxInt64)
+		}
+		return time.Duration(delay)
+	}
+}
+
+// Jittered returns a Backoff randomizing the delays of the backoff passed as parameter by up to the fraction of
+// their value, in both directions, to avoid retrying in lockstep with other clients.
+// the random function should return numbers in [0, 1), rand.Float64 is used if it's nil, and the delays are never negative.
+func Jittered(backoff Backoff, fraction float64, random func() float64) Backoff {
+	if random == nil {
+		random = rand.Float64
+	}
+	return func(attempt int) time.Duration {
+		delay := float64(backoff(attempt))
+		jittered := delay + delay*fraction*(2*random()-1)
+		switch {
+		case jittered < 0:
+			return 0
+		case jittered >= math.MaxInt64:
+			return time.Duration(math.MaxInt64)
+		}
+		return time.Duration(jittered)
+	}
+}
@@ -0,0 +1,71 @@
+package retry
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestBackoff(t *testing.T) {
+	testCases := []struct {
+		name     string
+		backoff  Backoff
+		expected []time.Duration
+	}{
+		{
+			name:     "Constant",
+			backoff:  Constant(time.Second),
+			expected: []time.Duration{time.Second, time.Second, time.Second},
+		},
+		{
+			name:     "Exponential",
+			backoff:  Exponential(100*time.Millisecond, 2, 0),
+			expected: []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond},
+		},
+		{
+			name:     "Exponential capped by its maximum",
+			backoff:  Exponential(100*time.Millisecond, 3, time.Second),
+			expected: []time.Duration{100 * time.Millisecond, 300 * time.Millisecond, 900 * time.Millisecond, time.Second, time.Second},
+		},
+		{
+			name:     "Jittered with the lowest random value",
+			backoff:  Jittered(Constant(time.Second), 0.5, func() float64 { return 0 }),
+			expected: []time.Duration{500 * time.Millisecond, 500 * time.Millisecond},
+		},
+		{
+			name:     "Jittered never negative",
+			backoff:  Jittered(Constant(time.Second), 1.5, func() float64 { return 0 }),
+			expected: []time.Duration{0, 0},
+		},
+		{
+			name:     "Jittered with the middle random value",
+			backoff:  Jittered(Exponential(time.Second, 2, 0), 0.5, func() float64 { return 0.5 }),
+			expected: []time.Duration{time.Second, 2 * time.Second},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			for i, expected := range testCase.expected {
+				assert.Equal(t, testCase.backoff(i+1), expected)
+			}
+		})
+	}
+}
+
+func TestExponentialOverflow(t *testing.T) {
+	assert.Equal(t, Exponential(time.Hour, 10, 0)(1000), time.Duration(math.MaxInt64))
+	assert.Equal(t, Exponential(time.Hour, 10, time.Minute)(1000), time.Minute)
+	assert.Equal(t, Exponential(time.Nanosecond, 2, 0)(64), time.Duration(math.MaxInt64))
+	assert.Equal(t, Exponential(time.Nanosecond, 2, 0)(63), time.Duration(1<<62))
+	assert.Equal(t, Jittered(Constant(math.MaxInt64), 0.5, func() float64 { return 0.99 })(1), time.Duration(math.MaxInt64))
+}
+
+func TestJitteredStaysInRange(t *testing.T) {
+	backoff := Jittered(Constant(time.Second), 0.2, nil)
+	for i := 1; i <= 1000; i++ {
+		delay := backoff(i)
+		assert.Assert(t, delay >= 800*time.Millisecond && delay <= 1200*time.Millisecond, "delay %v out of range", delay)
+	}
+}
@@ -0,0 +1,34 @@
+package retry
+
+import (
+	"context"
+	"time"
+)
+
+// Clock provides the time to a Policy and waits between attempts, it can be replaced in tests to run instantly.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// Sleep waits for the duration, it returns the cause of the context if it's done before.
+	Sleep(ctx context.Context, duration time.Duration) error
+}
+
+// SystemClock is the Clock based on the system time, used by default.
+type SystemClock struct{}
+
+// Now returns the current system time.
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// Sleep waits for the duration, it returns the cause of the context if it's done before.
+func (SystemClock) Sleep(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return context.Cause(ctx)
+	}
+}
@@ -0,0 +1,24 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestSystemClockSleep(t *testing.T) {
+	start := SystemClock{}.Now()
+	assert.NilError(t, SystemClock{}.Sleep(context.Background(), 5*time.Millisecond))
+	assert.Assert(t, SystemClock{}.Now().Sub(start) >= 5*time.Millisecond)
+}
+
+func TestSystemClockSleepCancelled(t *testing.T) {
+	cause := errors.New("shutting down")
+	ctx, cancel := context.WithCancelCause(context.Background())
+	cancel(cause)
+	err := SystemClock{}.Sleep(ctx, time.Hour)
+	assert.Assert(t, errors.Is(err, cause))
+}
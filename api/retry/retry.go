@@ -0,0 +1,153 @@
+// Package retry provides retry policies for the functions producing a Try.
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"glours/go2funk/api/control"
+)
+
+// Policy describes how a function is retried: the delays between its attempts, when to give up and which errors are retried.
+// the zero value of a Policy retries all the errors immediately and indefinitely, NewPolicy should be used to build one.
+type Policy struct {
+	backoff     Backoff
+	maxAttempts int
+	maxElapsed  time.Duration
+	retryable   func(error) bool
+	clock       Clock
+}
+
+// Option configures a Policy.
+type Option func(*Policy)
+
+// MaxAttempts limits the number of attempts, including the first one.
+func MaxAttempts(n int) Option {
+	return func(policy *Policy) {
+		policy.maxAttempts = n
+	}
+}
+
+// MaxElapsedTime stops retrying once the next attempt would start after the duration elapsed since the first one.
+func MaxElapsedTime(duration time.Duration) Option {
+	return func(policy *Policy) {
+		policy.maxElapsed = duration
+	}
+}
+
+// RetryIf only retries the errors validating the predicate, the other ones stop the retries immediately.
+func RetryIf(predicate func(error) bool) Option {
+	return func(policy *Policy) {
+		policy.retryable = predicate
+	}
+}
+
+// RetryOn only retries the errors matching one of the targets with errors.Is, the other ones stop the retries immediately.
+func RetryOn(targets ...error) Option {
+	return RetryIf(func(err error) bool {
+		for _, target := range targets {
+			if errors.Is(err, target) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// WithClock replaces the SystemClock used to measure the elapsed time and to wait between attempts.
+func WithClock(clock Clock) Option {
+	return func(policy *Policy) {
+		policy.clock = clock
+	}
+}
+
+// NewPolicy returns a Policy waiting between attempts according to the Backoff and configured by the options.
+// the attempts are not limited unless MaxAttempts or MaxElapsedTime is used.
+func NewPolicy(backoff Backoff, options ...Option) Policy {
+	policy := Policy{backoff: backoff}
+	for _, option := range options {
+		option(&policy)
+	}
+	return policy
+}
+
+// Error is the cause of the failure returned by Retry, with the errors of all the attempts in order.
+// it also contains the cause of the context if it was done before the attempts were exhausted.
+type Error struct {
+	Attempts []error
+	Cause    error
+}
+
+// Error returns the number of attempts followed by their errors.
+func (e *Error) Error() string {
+	messages := make([]string, 0, len(e.Attempts)+1)
+	for _, attempt := range e.Attempts {
+		messages = append(messages, attempt.Error())
+	}
+	if e.Cause != nil {
+		messages = append(messages, e.Cause.Error())
+	}
+	return fmt.Sprintf("retry: %d failed attempts: %s", len(e.Attempts), strings.Join(messages, "; "))
+}
+
+// Unwrap returns the errors of the attempts and the cause of the context, if any, so they can be matched with errors.Is
+// or errors.As.
+func (e *Error) Unwrap() []error {
+	if e.Cause == nil {
+		return e.Attempts
+	}
+	return append(append([]error{}, e.Attempts...), e.Cause)
+}
+
+// Retry calls the lambda until it succeeds or the Policy gives up, and returns a Try with its value.
+// the failure has an Error cause with the errors of all the attempts, and stops waiting as soon as the context is done.
+func Retry[A any](ctx context.Context, policy Policy, lambda func() (A, error)) control.Try[A] {
+	clock := policy.clock
+	if clock == nil {
+		clock = SystemClock{}
+	}
+	start := clock.Now()
+	var attempts []error
+	for attempt := 1; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return control.FailureOf[A](&Error{Attempts: attempts, Cause: context.Cause(ctx)})
+		}
+		value, err := lambda()
+		if err == nil {
+			return control.SuccessOf(value)
+		}
+		attempts = append(attempts, err)
+		delay := policy.delay(attempt)
+		if !policy.retries(attempt, err, clock.Now().Add(delay).Sub(start)) {
+			return control.FailureOf[A](&Error{Attempts: attempts})
+		}
+		if err := clock.Sleep(ctx, delay); err != nil {
+			return control.FailureOf[A](&Error{Attempts: attempts, Cause: err})
+		}
+	}
+}
+
+// delay is an internal function returning the delay to wait after the attempt.
+func (p Policy) delay(attempt int) time.Duration {
+	if p.backoff == nil {
+		return 0
+	}
+	return p.backoff(attempt)
+}
+
+// retries is an internal function checking if the Policy retries after the attempt failed with the error, the next
+// attempt starting once the elapsed duration is over.
+func (p Policy) retries(attempt int, err error, elapsed time.Duration) bool {
+	switch {
+	case p.maxAttempts > 0 && attempt >= p.maxAttempts:
+		return false
+	case p.maxElapsed > 0 && elapsed > p.maxElapsed:
+		return false
+	case p.retryable != nil && !p.retryable(err):
+		return false
+	}
+	return true
+}
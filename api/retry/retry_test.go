@@ -0,0 +1,194 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+var (
+	errUnavailable = errors.New("unavailable")
+	errNotFound    = errors.New("not found")
+)
+
+// fakeClock advances instantly on Sleep and records the delays.
+type fakeClock struct {
+	now     time.Time
+	sleeps  []time.Duration
+	onSleep func()
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Sleep(ctx context.Context, duration time.Duration) error {
+	if c.onSleep != nil {
+		c.onSleep()
+	}
+	if err := ctx.Err(); err != nil {
+		return context.Cause(ctx)
+	}
+	c.sleeps = append(c.sleeps, duration)
+	c.now = c.now.Add(duration)
+	return nil
+}
+
+// failing returns a function failing with the errors in order before succeeding with the number of calls.
+func failing(errs ...error) (func() (int, error), *int) {
+	calls := 0
+	return func() (int, error) {
+		calls++
+		if calls <= len(errs) {
+			return 0, errs[calls-1]
+		}
+		return calls, nil
+	}, &calls
+}
+
+// assertErrors checks the errors are the expected ones, in order.
+func assertErrors(t *testing.T, errs []error, expected []error) {
+	t.Helper()
+	assert.Equal(t, len(errs), len(expected))
+	for i := range expected {
+		assert.Equal(t, errs[i], expected[i])
+	}
+}
+
+func TestRetry(t *testing.T) {
+	testCases := []struct {
+		name             string
+		backoff          Backoff
+		options          []Option
+		errs             []error
+		expected         int
+		expectedCalls    int
+		expectedSleeps   []time.Duration
+		expectedFailures []error
+	}{
+		{
+			name:          "succeeds at the first attempt",
+			backoff:       Constant(time.Second),
+			options:       []Option{MaxAttempts(3)},
+			expected:      1,
+			expectedCalls: 1,
+		},
+		{
+			name:           "succeeds after failures",
+			backoff:        Exponential(time.Second, 2, 0),
+			options:        []Option{MaxAttempts(5)},
+			errs:           []error{errUnavailable, errUnavailable, errUnavailable},
+			expected:       4,
+			expectedCalls:  4,
+			expectedSleeps: []time.Duration{time.Second, 2 * time.Second, 4 * time.Second},
+		},
+		{
+			name:             "gives up after max attempts",
+			backoff:          Constant(time.Second),
+			options:          []Option{MaxAttempts(2)},
+			errs:             []error{errUnavailable, errNotFound, errUnavailable},
+			expectedCalls:    2,
+			expectedSleeps:   []time.Duration{time.Second},
+			expectedFailures: []error{errUnavailable, errNotFound},
+		},
+		{
+			name:             "gives up after max elapsed time",
+			backoff:          Exponential(time.Second, 2, 0),
+			options:          []Option{MaxElapsedTime(5 * time.Second)},
+			errs:             []error{errUnavailable, errUnavailable, errUnavailable, errUnavailable},
+			expectedCalls:    3,
+			expectedSleeps:   []time.Duration{time.Second, 2 * time.Second},
+			expectedFailures: []error{errUnavailable, errUnavailable, errUnavailable},
+		},
+		{
+			name:             "stops on non retryable errors",
+			backoff:          Constant(time.Second),
+			options:          []Option{RetryOn(errUnavailable)},
+			errs:             []error{errUnavailable, errNotFound, errUnavailable},
+			expectedCalls:    2,
+			expectedSleeps:   []time.Duration{time.Second},
+			expectedFailures: []error{errUnavailable, errNotFound},
+		},
+		{
+			name:           "retries wrapped errors",
+			backoff:        Constant(time.Second),
+			options:        []Option{RetryOn(errUnavailable)},
+			errs:           []error{errors.Join(errors.New("backend"), errUnavailable)},
+			expected:       2,
+			expectedCalls:  2,
+			expectedSleeps: []time.Duration{time.Second},
+		},
+		{
+			name:             "retries with a predicate",
+			backoff:          nil,
+			options:          []Option{RetryIf(func(err error) bool { return !errors.Is(err, errNotFound) })},
+			errs:             []error{errUnavailable, errUnavailable, errNotFound},
+			expectedCalls:    3,
+			expectedSleeps:   []time.Duration{0, 0},
+			expectedFailures: []error{errUnavailable, errUnavailable, errNotFound},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			clock := &fakeClock{}
+			lambda, calls := failing(testCase.errs...)
+			policy := NewPolicy(testCase.backoff, append(testCase.options, WithClock(clock))...)
+			value, err := Retry(context.Background(), policy, lambda).OrElseCause()
+			assert.Equal(t, *calls, testCase.expectedCalls)
+			assert.DeepEqual(t, clock.sleeps, testCase.expectedSleeps)
+			if testCase.expectedFailures == nil {
+				assert.NilError(t, err)
+				assert.Equal(t, value, testCase.expected)
+				return
+			}
+			var retryErr *Error
+			assert.Assert(t, errors.As(err, &retryErr))
+			assertErrors(t, retryErr.Attempts, testCase.expectedFailures)
+			assert.NilError(t, retryErr.Cause)
+			for _, failure := range testCase.expectedFailures {
+				assert.Assert(t, errors.Is(err, failure))
+			}
+		})
+	}
+}
+
+func TestRetryCancelled(t *testing.T) {
+	cause := errors.New("shutting down")
+	ctx, cancel := context.WithCancelCause(context.Background())
+	defer cancel(nil)
+	clock := &fakeClock{}
+	clock.onSleep = func() {
+		if len(clock.sleeps) == 1 {
+			cancel(cause)
+		}
+	}
+	lambda, calls := failing(errUnavailable, errUnavailable, errUnavailable)
+	_, err := Retry(ctx, NewPolicy(Constant(time.Second), WithClock(clock)), lambda).OrElseCause()
+	assert.Equal(t, *calls, 2)
+	var retryErr *Error
+	assert.Assert(t, errors.As(err, &retryErr))
+	assertErrors(t, retryErr.Attempts, []error{errUnavailable, errUnavailable})
+	assert.Assert(t, errors.Is(err, cause))
+	assert.Assert(t, errors.Is(err, errUnavailable))
+	assert.Equal(t, err.Error(), "retry: 2 failed attempts: unavailable; unavailable; shutting down")
+}
+
+func TestRetryAlreadyCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	lambda, calls := failing()
+	_, err := Retry(ctx, NewPolicy(Constant(time.Second)), lambda).OrElseCause()
+	assert.Equal(t, *calls, 0)
+	assert.Assert(t, errors.Is(err, context.Canceled))
+}
+
+func TestRetryWithSystemClock(t *testing.T) {
+	lambda, calls := failing(errUnavailable, errUnavailable)
+	value, err := Retry(context.Background(), NewPolicy(Constant(time.Millisecond), MaxAttempts(3)), lambda).OrElseCause()
+	assert.NilError(t, err)
+	assert.Equal(t, value, 3)
+	assert.Equal(t, *calls, 3)
+}
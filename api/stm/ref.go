@@ -0,0 +1,104 @@
+package stm
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Ref is a transactional reference to a value, read and written by transactions run with Atomically.
+// the value should be immutable, such as a persistent collection, as it's shared with the transactions reading it.
+// NewRef should be used to build a Ref.
+type Ref[T any] struct {
+	id       uint64
+	state    atomic.Pointer[refState[T]]
+	isLocked atomic.Bool
+	mutex    sync.Mutex
+}
+
+// refState is the internal box of the value of a Ref with the version of the transaction which committed it.
+type refState[T any] struct {
+	value   T
+	version uint64
+}
+
+// ref is the internal view of a Ref of any type used by the transactions.
+type ref interface {
+	identity() uint64
+	version() uint64
+	locked() bool
+	lock()
+	unlock()
+	install(value any, version uint64)
+}
+
+// NewRef returns a new Ref holding the value passed as parameter.
+func NewRef[T any](value T) *Ref[T] {
+	r := &Ref[T]{id: identities.Add(1)}
+	r.state.Store(&refState[T]{value: value})
+	return r
+}
+
+// Load returns the last committed value of the Ref, outside of any transaction.
+func (r *Ref[T]) Load() T {
+	return r.state.Load().value
+}
+
+// Get returns the value of the Ref in the transaction, which is the value written by the transaction if any.
+func (r *Ref[T]) Get(tx *Tx) T {
+	if value, ok := tx.writes[r]; ok {
+		written, _ := value.(T)
+		return written
+	}
+	if r.isLocked.Load() {
+		panic(conflict)
+	}
+	state := r.state.Load()
+	if r.isLocked.Load() || state.version > tx.readVersion {
+		panic(conflict)
+	}
+	tx.reads[r] = struct{}{}
+	return state.value
+}
+
+// Set writes the value passed as parameter to the Ref in the transaction, it's visible to the other transactions once committed.
+func (r *Ref[T]) Set(tx *Tx, value T) {
+	tx.writes[r] = value
+}
+
+// Update writes the result of the update function applied to the value of the Ref in the transaction.
+func (r *Ref[T]) Update(tx *Tx, update func(T) T) {
+	r.Set(tx, update(r.Get(tx)))
+}
+
+// identity is an internal function returning the unique identity of the Ref.
+func (r *Ref[T]) identity() uint64 {
+	return r.id
+}
+
+// version is an internal function returning the version of the transaction which committed the current value.
+func (r *Ref[T]) version() uint64 {
+	return r.state.Load().version
+}
+
+// locked is an internal function checking if a transaction is committing a write to the Ref.
+func (r *Ref[T]) locked() bool {
+	return r.isLocked.Load()
+}
+
+// lock is an internal function taken by a transaction before committing a write to the Ref.
+func (r *Ref[T]) lock() {
+	r.mutex.Lock()
+	r.isLocked.Store(true)
+}
+
+// unlock is an internal function releasing the lock taken by lock.
+func (r *Ref[T]) unlock() {
+	r.isLocked.Store(false)
+	r.mutex.Unlock()
+}
+
+// install is an internal function replacing the value of the locked Ref with a value committed at the version.
+func (r *Ref[T]) install(value any, version uint64) {
+	installed, _ := value.(T)
+	r.state.Store(&refState[T]{value: installed, version: version})
+}
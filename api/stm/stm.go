@@ -0,0 +1,167 @@
+// Package stm provides a software transactional memory coordinating updates of several Refs holding persistent values.
+// transactions read a consistent snapshot of the Refs and are committed atomically, or retried on conflict, following
+// the TL2 algorithm: a global version clock, a version per Ref and locks taken on the written Refs at commit time only.
+package stm
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrNothingToRetry is returned by Atomically when a transaction calls Retry without having read any Ref, as nothing
+// could ever wake it up.
+var ErrNothingToRetry = errors.New("stm: Retry called without reading any Ref")
+
+var (
+	// clock is the global version clock, incremented by each transaction committing writes.
+	clock atomic.Uint64
+	// identities provides a unique identity to each Ref, used to lock the written Refs in a consistent order.
+	identities atomic.Uint64
+	// changes is closed and replaced each time a transaction commits writes, waking up the retrying transactions.
+	changes     = make(chan struct{})
+	changesLock sync.Mutex
+)
+
+// signal is the internal type of the values used to unwind a transaction.
+type signal int
+
+const (
+	// completed is the signal of a transaction which returned normally.
+	completed signal = iota
+	// conflict unwinds a transaction which read a Ref modified since it started, so it's restarted.
+	conflict
+	// retry unwinds a transaction which called Retry, so it's restarted once one of the Refs it read is modified.
+	retry
+)
+
+// Tx is a running transaction, passed to the functions given to Atomically to read and write Refs.
+// a Tx should not be used outside of the function it was passed to.
+type Tx struct {
+	readVersion uint64
+	reads       map[ref]struct{}
+	writes      map[ref]any
+}
+
+// Atomically runs the function passed as parameter in a transaction and commits its writes atomically.
+// the function is run again from the start if another transaction committed a conflicting write in the meantime, or
+// once one of the Refs it read is modified if it called Retry, so it should be free of side effects.
+// the transaction is aborted without committing any write if the function returns an error, which is returned.
+func Atomically(transaction func(tx *Tx) error) error {
+	for {
+		tx := &Tx{readVersion: clock.Load(), reads: map[ref]struct{}{}, writes: map[ref]any{}}
+		unwound, err := tx.run(transaction)
+		switch {
+		case unwound == retry:
+			if len(tx.reads) == 0 {
+				return ErrNothingToRetry
+			}
+			tx.awaitChange()
+		case unwound == conflict:
+		case err != nil:
+			return err
+		case tx.commit():
+			return nil
+		}
+	}
+}
+
+// Retry aborts the current transaction and runs it again once one of the Refs it read is modified, it never returns.
+// it allows to block until a condition on the Refs is met, such as a queue not being empty.
+func Retry(tx *Tx) {
+	panic(retry)
+}
+
+// OrElse returns a transaction running the first one and, if it calls Retry, discarding its writes and running the second one.
+// the returned transaction calls Retry if both of them do, and is then run again once any of the Refs read by either is modified.
+func OrElse(first, second func(tx *Tx) error) func(tx *Tx) error {
+	return func(tx *Tx) error {
+		writes := make(map[ref]any, len(tx.writes))
+		for ref, value := range tx.writes {
+			writes[ref] = value
+		}
+		unwound, err := tx.run(first)
+		switch unwound {
+		case retry:
+			tx.writes = writes
+			return second(tx)
+		case conflict:
+			panic(conflict)
+		}
+		return err
+	}
+}
+
+// run is an internal function running the transaction and reporting the signal it was unwound with, if any.
+// the panics which aren't signals are propagated.
+func (tx *Tx) run(transaction func(tx *Tx) error) (unwound signal, err error) {
+	defer func() {
+		if value := recover(); value != nil {
+			signal, ok := value.(signal)
+			if !ok {
+				panic(value)
+			}
+			unwound = signal
+		}
+	}()
+	return completed, transaction(tx)
+}
+
+// commit is an internal function trying to commit the writes of the transaction.
+// it returns false if one of the Refs read was modified by another transaction since the transaction started.
+func (tx *Tx) commit() bool {
+	if len(tx.writes) == 0 {
+		return true
+	}
+	written := make([]ref, 0, len(tx.writes))
+	for ref := range tx.writes {
+		written = append(written, ref)
+	}
+	sort.Slice(written, func(i, j int) bool { return written[i].identity() < written[j].identity() })
+	for _, ref := range written {
+		ref.lock()
+	}
+	writeVersion := clock.Add(1)
+	valid := writeVersion == tx.readVersion+1 || tx.validate()
+	if valid {
+		for _, ref := range written {
+			ref.install(tx.writes[ref], writeVersion)
+		}
+	}
+	for _, ref := range written {
+		ref.unlock()
+	}
+	if valid {
+		changesLock.Lock()
+		close(changes)
+		changes = make(chan struct{})
+		changesLock.Unlock()
+	}
+	return valid
+}
+
+// validate is an internal function checking that the Refs read weren't modified or locked by another transaction.
+func (tx *Tx) validate() bool {
+	for ref := range tx.reads {
+		if _, own := tx.writes[ref]; !own && ref.locked() || ref.version() > tx.readVersion {
+			return false
+		}
+	}
+	return true
+}
+
+// awaitChange is an internal function blocking until one of the Refs read by the transaction is modified.
+func (tx *Tx) awaitChange() {
+	for {
+		changesLock.Lock()
+		changed := changes
+		changesLock.Unlock()
+		for ref := range tx.reads {
+			if ref.version() > tx.readVersion {
+				return
+			}
+		}
+		<-changed
+	}
+}
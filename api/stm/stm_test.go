@@ -0,0 +1,199 @@
+package stm
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"glours/go2funk/api/collection"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/assert/cmp"
+)
+
+var errInsufficientFunds = errors.New("insufficient funds")
+
+func transfer(from, to *Ref[int], audit *Ref[collection.List[string]], amount int) func(tx *Tx) error {
+	return func(tx *Tx) error {
+		if from.Get(tx) < amount {
+			return errInsufficientFunds
+		}
+		from.Update(tx, func(balance int) int { return balance - amount })
+		to.Update(tx, func(balance int) int { return balance + amount })
+		audit.Update(tx, func(entries collection.List[string]) collection.List[string] { return entries.Append("transfer") })
+		return nil
+	}
+}
+
+func TestAtomically(t *testing.T) {
+	from, to, audit := NewRef(100), NewRef(0), NewRef(collection.Empty[string]())
+
+	assert.NilError(t, Atomically(transfer(from, to, audit, 30)))
+	assert.Equal(t, from.Load(), 70)
+	assert.Equal(t, to.Load(), 30)
+	assert.Equal(t, audit.Load().Length(), 1)
+
+	err := Atomically(transfer(from, to, audit, 100))
+	assert.Equal(t, err, errInsufficientFunds)
+	assert.Equal(t, from.Load(), 70, "an aborted transaction should not commit any write")
+	assert.Equal(t, audit.Load().Length(), 1)
+}
+
+func TestTransactionReadsItsOwnWrites(t *testing.T) {
+	counter := NewRef(1)
+	err := Atomically(func(tx *Tx) error {
+		counter.Set(tx, 2)
+		assert.Equal(t, counter.Get(tx), 2)
+		assert.Equal(t, counter.Load(), 1, "writes should not be visible before the commit")
+		return nil
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, counter.Load(), 2)
+}
+
+func TestConcurrentTransfers(t *testing.T) {
+	accounts := []*Ref[int]{NewRef(1000), NewRef(1000), NewRef(1000), NewRef(1000)}
+	audit := NewRef(collection.Empty[string]())
+	var group sync.WaitGroup
+	for worker := 0; worker < 8; worker++ {
+		group.Add(1)
+		go func(worker int) {
+			defer group.Done()
+			for i := 0; i < 200; i++ {
+				from, to := accounts[(worker+i)%len(accounts)], accounts[(worker+i+1)%len(accounts)]
+				assert.Check(t, Atomically(transfer(from, to, audit, 1)))
+			}
+		}(worker)
+	}
+	group.Add(1)
+	go func() {
+		defer group.Done()
+		for i := 0; i < 200; i++ {
+			var total int
+			assert.Check(t, Atomically(func(tx *Tx) error {
+				total = 0
+				for _, account := range accounts {
+					total += account.Get(tx)
+				}
+				return nil
+			}))
+			assert.Check(t, cmp.Equal(total, 4000), "a transaction should read a consistent snapshot")
+		}
+	}()
+	group.Wait()
+
+	total := 0
+	for _, account := range accounts {
+		total += account.Load()
+	}
+	assert.Equal(t, total, 4000)
+	assert.Equal(t, audit.Load().Length(), 1600, "no transfer should be lost")
+}
+
+func TestRetry(t *testing.T) {
+	queue := NewRef(collection.Empty[int]())
+	received := make(chan int)
+	go func() {
+		var value int
+		assert.Check(t, Atomically(func(tx *Tx) error {
+			values := queue.Get(tx)
+			if values.IsEmpty() {
+				Retry(tx)
+			}
+			value = values.HeadOption().OrElse(0)
+			rest, err := values.RemoveAt(0)
+			queue.Set(tx, rest)
+			return err
+		}))
+		received <- value
+	}()
+
+	select {
+	case <-received:
+		t.Fatal("the transaction should wait for the queue to be filled")
+	case <-time.After(20 * time.Millisecond):
+	}
+	assert.NilError(t, Atomically(func(tx *Tx) error {
+		queue.Set(tx, collection.Of(42))
+		return nil
+	}))
+	select {
+	case value := <-received:
+		assert.Equal(t, value, 42)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the transaction should be retried once the queue is filled")
+	}
+	assert.Assert(t, queue.Load().IsEmpty())
+}
+
+func TestRetryWithoutRead(t *testing.T) {
+	err := Atomically(func(tx *Tx) error {
+		Retry(tx)
+		return nil
+	})
+	assert.Equal(t, err, ErrNothingToRetry)
+}
+
+func TestOrElse(t *testing.T) {
+	first, second, log := NewRef(0), NewRef(5), NewRef(collection.Empty[string]())
+	take := func(ref *Ref[int], name string) func(tx *Tx) error {
+		return func(tx *Tx) error {
+			log.Update(tx, func(entries collection.List[string]) collection.List[string] { return entries.Append(name) })
+			if ref.Get(tx) == 0 {
+				Retry(tx)
+			}
+			ref.Update(tx, func(value int) int { return value - 1 })
+			return nil
+		}
+	}
+
+	assert.NilError(t, Atomically(OrElse(take(first, "first"), take(second, "second"))))
+	assert.Equal(t, first.Load(), 0)
+	assert.Equal(t, second.Load(), 4)
+	assert.Assert(t, log.Load().Equals(collection.Of("second")), "writes of the retried alternative should be discarded, got %v", log.Load())
+
+	assert.NilError(t, Atomically(func(tx *Tx) error {
+		first.Set(tx, 1)
+		return nil
+	}))
+	assert.NilError(t, Atomically(OrElse(take(first, "first"), take(second, "second"))))
+	assert.Equal(t, first.Load(), 0)
+	assert.Equal(t, second.Load(), 4)
+}
+
+func TestOrElseRetriesWhenBothRetry(t *testing.T) {
+	first, second := NewRef(0), NewRef(0)
+	wait := func(ref *Ref[int]) func(tx *Tx) error {
+		return func(tx *Tx) error {
+			if ref.Get(tx) == 0 {
+				Retry(tx)
+			}
+			return nil
+		}
+	}
+	done := make(chan error)
+	go func() {
+		done <- Atomically(OrElse(wait(first), wait(second)))
+	}()
+	time.Sleep(10 * time.Millisecond)
+	assert.NilError(t, Atomically(func(tx *Tx) error {
+		second.Set(tx, 1)
+		return nil
+	}))
+	select {
+	case err := <-done:
+		assert.NilError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the transaction should be retried once a Ref read by the second alternative is modified")
+	}
+}
+
+func TestPanicsArePropagated(t *testing.T) {
+	defer func() {
+		assert.Equal(t, recover(), "unexpected")
+	}()
+	_ = Atomically(func(tx *Tx) error {
+		panic("unexpected")
+	})
+	t.Fatal("the panic should be propagated")
+}
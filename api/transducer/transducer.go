@@ -0,0 +1,176 @@
+// Package transducer provides composable transformations of reducing functions, independent of the source of the values.
+package transducer
+
+import (
+	"fmt"
+	"reflect"
+
+	"glours/go2funk/api/collection"
+)
+
+// Reducer consumes the values of type T produced by a source one by one.
+// Step returns false to request the early termination of the process, and Complete is called once when the source
+// is exhausted or the process terminated, so a Reducer can flush any buffered value.
+type Reducer[T any] struct {
+	Step     func(T) bool
+	Complete func()
+}
+
+// Transducer transforms a Reducer of B values into a Reducer of A values.
+// a Transducer doesn't hold any state by itself, the state of a stateful Transducer such as Taking is created each
+// time it is applied, so the same Transducer value can be run several times and over several sources.
+type Transducer[A, B any] func(Reducer[B]) Reducer[A]
+
+// Compose returns a Transducer applying the first Transducer and then the second one to the values.
+func Compose[A, B, C any](first Transducer[A, B], second Transducer[B, C]) Transducer[A, C] {
+	return func(reducer Reducer[C]) Reducer[A] {
+		return first(second(reducer))
+	}
+}
+
+// Chain returns a Transducer applying all the Transducers passed as parameter in order.
+// the identity Transducer is returned if no Transducer is passed.
+func Chain[T any](transducers ...Transducer[T, T]) Transducer[T, T] {
+	return func(reducer Reducer[T]) Reducer[T] {
+		for i := len(transducers) - 1; i >= 0; i-- {
+			reducer = transducers[i](reducer)
+		}
+		return reducer
+	}
+}
+
+// Mapping returns a Transducer mapping each value of type A to a value of type B.
+func Mapping[A, B any](mapper func(A) B) Transducer[A, B] {
+	return func(reducer Reducer[B]) Reducer[A] {
+		return Reducer[A]{
+			Step: func(value A) bool {
+				return reducer.Step(mapper(value))
+			},
+			Complete: reducer.Complete,
+		}
+	}
+}
+
+// Filtering returns a Transducer keeping only the values which are validating the predicate passed as parameter.
+func Filtering[T any](predicate func(T) bool) Transducer[T, T] {
+	return func(reducer Reducer[T]) Reducer[T] {
+		return Reducer[T]{
+			Step: func(value T) bool {
+				return !predicate(value) || reducer.Step(value)
+			},
+			Complete: reducer.Complete,
+		}
+	}
+}
+
+// Taking returns a Transducer keeping at most the n first values and terminating the process once they are produced.
+func Taking[T any](n int) Transducer[T, T] {
+	return func(reducer Reducer[T]) Reducer[T] {
+		taken := 0
+		return Reducer[T]{
+			Step: func(value T) bool {
+				if taken >= n {
+					return false
+				}
+				taken++
+				return reducer.Step(value) && taken < n
+			},
+			Complete: reducer.Complete,
+		}
+	}
+}
+
+// Deduping returns a Transducer removing the consecutive duplicated values.
+// values are compared with reflect.DeepEqual.
+func Deduping[T any]() Transducer[T, T] {
+	return func(reducer Reducer[T]) Reducer[T] {
+		var previous T
+		started := false
+		return Reducer[T]{
+			Step: func(value T) bool {
+				if started && reflect.DeepEqual(previous, value) {
+					return true
+				}
+				previous, started = value, true
+				return reducer.Step(value)
+			},
+			Complete: reducer.Complete,
+		}
+	}
+}
+
+// Partitioning returns a Transducer grouping the values in Lists of size elements, the last List may be shorter.
+// this function returns error if the size is less than or equal to 0.
+func Partitioning[T any](size int) (Transducer[T, collection.List[T]], error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("invalid partition size %d", size)
+	}
+	return func(reducer Reducer[collection.List[T]]) Reducer[T] {
+		var partition []T
+		stopped := false
+		return Reducer[T]{
+			Step: func(value T) bool {
+				if partition = append(partition, value); len(partition) < size {
+					return true
+				}
+				full := collection.OfSlice(partition)
+				partition = partition[:0]
+				stopped = !reducer.Step(full)
+				return !stopped
+			},
+			Complete: func() {
+				if !stopped && len(partition) > 0 {
+					reducer.Step(collection.OfSlice(partition))
+				}
+				reducer.Complete()
+			},
+		}
+	}, nil
+}
+
+// TransduceList runs the Transducer over the elements of the List and folds the produced values from left to right
+// with the folder function, starting from zero.
+func TransduceList[A, B, U any](list collection.List[A], transducer Transducer[A, B], zero U, folder func(U, B) U) U {
+	return run(transducer, zero, folder, list.ForEachWhile)
+}
+
+// TransduceSlice runs the Transducer over the elements of the slice and folds the produced values from left to right
+// with the folder function, starting from zero.
+func TransduceSlice[A, B, U any](values []A, transducer Transducer[A, B], zero U, folder func(U, B) U) U {
+	return run(transducer, zero, folder, func(step func(A) bool) {
+		for _, value := range values {
+			if !step(value) {
+				return
+			}
+		}
+	})
+}
+
+// TransduceChannel runs the Transducer over the values received from the channel until it's closed and folds the
+// produced values with the folder function, starting from zero.
+// the channel isn't drained anymore once the Transducer terminates the process early.
+func TransduceChannel[A, B, U any](values <-chan A, transducer Transducer[A, B], zero U, folder func(U, B) U) U {
+	return run(transducer, zero, folder, func(step func(A) bool) {
+		for value := range values {
+			if !step(value) {
+				return
+			}
+		}
+	})
+}
+
+// run is an internal function applying the Transducer to a Reducer folding the values, feeding it with the source
+// and completing it once the source is exhausted or the process terminated.
+func run[A, B, U any](transducer Transducer[A, B], zero U, folder func(U, B) U, source func(func(A) bool)) U {
+	result := zero
+	reducer := transducer(Reducer[B]{
+		Step: func(value B) bool {
+			result = folder(result, value)
+			return true
+		},
+		Complete: func() {},
+	})
+	source(reducer.Step)
+	reducer.Complete()
+	return result
+}
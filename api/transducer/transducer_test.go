@@ -0,0 +1,118 @@
+package transducer
+
+import (
+	"strconv"
+	"testing"
+
+	"glours/go2funk/api/collection"
+	"gotest.tools/v3/assert"
+)
+
+var (
+	evenPredicate = func(value int) bool { return value%2 == 0 }
+	appendInt     = func(acc []int, value int) []int { return append(acc, value) }
+	appendString  = func(acc []string, value string) []string { return append(acc, value) }
+)
+
+func channelOf[T any](values ...T) <-chan T {
+	channel := make(chan T, len(values))
+	for _, value := range values {
+		channel <- value
+	}
+	close(channel)
+	return channel
+}
+
+func TestTransducers(t *testing.T) {
+	testCases := []struct {
+		name       string
+		values     []int
+		transducer Transducer[int, int]
+		expected   []int
+	}{
+		{name: "Empty Chain", values: []int{1, 2, 3}, transducer: Chain[int](), expected: []int{1, 2, 3}},
+		{name: "Mapping", values: []int{1, 2, 3}, transducer: Mapping(func(value int) int { return value * 10 }), expected: []int{10, 20, 30}},
+		{name: "Filtering", values: []int{1, 2, 3, 4, 5}, transducer: Filtering(evenPredicate), expected: []int{2, 4}},
+		{name: "Taking", values: []int{1, 2, 3, 4, 5}, transducer: Taking[int](2), expected: []int{1, 2}},
+		{name: "Taking nothing", values: []int{1, 2, 3}, transducer: Taking[int](0), expected: nil},
+		{name: "Taking more than available", values: []int{1, 2}, transducer: Taking[int](5), expected: []int{1, 2}},
+		{name: "Deduping", values: []int{1, 1, 2, 2, 2, 1, 3, 3}, transducer: Deduping[int](), expected: []int{1, 2, 1, 3}},
+		{
+			name:       "Chain",
+			values:     []int{1, 2, 2, 3, 4, 4, 5, 6, 8},
+			transducer: Chain(Deduping[int](), Filtering(evenPredicate), Taking[int](3)),
+			expected:   []int{2, 4, 6},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			fromSlice := TransduceSlice(testCase.values, testCase.transducer, nil, appendInt)
+			assert.DeepEqual(t, fromSlice, testCase.expected)
+			fromList := TransduceList(collection.OfSlice(testCase.values), testCase.transducer, nil, appendInt)
+			assert.DeepEqual(t, fromList, testCase.expected)
+			fromChannel := TransduceChannel(channelOf(testCase.values...), testCase.transducer, nil, appendInt)
+			assert.DeepEqual(t, fromChannel, testCase.expected)
+		})
+	}
+}
+
+func TestCompose(t *testing.T) {
+	pipeline := Compose(Filtering(evenPredicate), Mapping(strconv.Itoa))
+	assert.DeepEqual(t, TransduceSlice([]int{1, 2, 3, 4}, pipeline, nil, appendString), []string{"2", "4"})
+	assert.DeepEqual(t, TransduceList(collection.OfSlice([]int{5, 6}), pipeline, nil, appendString), []string{"6"})
+}
+
+func TestPartitioning(t *testing.T) {
+	_, err := Partitioning[int](0)
+	assert.Error(t, err, "invalid partition size 0")
+
+	partitioning, err := Partitioning[int](2)
+	assert.NilError(t, err)
+	toStrings := func(acc []string, partition collection.List[int]) []string { return append(acc, partition.String()) }
+
+	testCases := []struct {
+		name       string
+		transducer Transducer[int, collection.List[int]]
+		expected   []string
+	}{
+		{name: "Partitioning", transducer: partitioning, expected: []string{"List(1, 2)", "List(3, 4)", "List(5)"}},
+		{
+			name:       "Partitioning then Taking",
+			transducer: Compose(partitioning, Taking[collection.List[int]](1)),
+			expected:   []string{"List(1, 2)"},
+		},
+		{
+			name:       "Taking then Partitioning",
+			transducer: Compose(Taking[int](3), partitioning),
+			expected:   []string{"List(1, 2)", "List(3)"},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := TransduceList(collection.OfSlice([]int{1, 2, 3, 4, 5}), testCase.transducer, nil, toStrings)
+			assert.DeepEqual(t, result, testCase.expected)
+			result = TransduceChannel(channelOf(1, 2, 3, 4, 5), testCase.transducer, nil, toStrings)
+			assert.DeepEqual(t, result, testCase.expected)
+		})
+	}
+}
+
+func TestEarlyTermination(t *testing.T) {
+	consumed := 0
+	counting := Mapping(func(value int) int {
+		consumed++
+		return value
+	})
+	sum := TransduceList(collection.Tabulate(1000, func(i int) int { return i }), Compose(counting, Taking[int](3)), 0,
+		func(acc int, value int) int { return acc + value })
+	assert.Equal(t, sum, 3)
+	assert.Equal(t, consumed, 3)
+
+	channel := make(chan int, 10)
+	for i := 0; i < 10; i++ {
+		channel <- i
+	}
+	close(channel)
+	TransduceChannel(channel, Taking[int](4), nil, appendInt)
+	assert.Equal(t, len(channel), 6, "remaining values should be left in the channel")
+}
@@ -0,0 +1,4 @@
+package api
+
+// Tuple3 to Tuple8 product types are generated from the template of the internal/gen/tuple command.
+//go:generate go run ./internal/gen/tuple
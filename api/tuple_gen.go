@@ -0,0 +1,480 @@
+// Code generated by go run ./internal/gen/tuple; DO NOT EDIT.
+
+package api
+
+// Tuple3 is a product type containing 3 values of possibly different types.
+type Tuple3[T1, T2, T3 any] struct {
+	v1 T1
+	v2 T2
+	v3 T3
+}
+
+// NewTuple3 returns a Tuple3 containing the values passed as parameter.
+func NewTuple3[T1, T2, T3 any](v1 T1, v2 T2, v3 T3) Tuple3[T1, T2, T3] {
+	return Tuple3[T1, T2, T3]{v1, v2, v3}
+}
+
+// Tuple3OfPairs returns a Tuple3 containing the values of right nested pairs.
+func Tuple3OfPairs[T1, T2, T3 any](pairs Pair[T1, Pair[T2, T3]]) Tuple3[T1, T2, T3] {
+	return Tuple3[T1, T2, T3]{pairs.left, pairs.right.left, pairs.right.right}
+}
+
+// MapTuple3 maps each value of a Tuple3 to a new Tuple3 with the mappers passed as parameter.
+func MapTuple3[T1, T2, T3, U1, U2, U3 any](tuple Tuple3[T1, T2, T3], mapper1 func(T1) U1, mapper2 func(T2) U2, mapper3 func(T3) U3) Tuple3[U1, U2, U3] {
+	return Tuple3[U1, U2, U3]{mapper1(tuple.v1), mapper2(tuple.v2), mapper3(tuple.v3)}
+}
+
+// Map1Tuple3 maps the value at position 1 of a Tuple3 with the mapper passed as parameter.
+func Map1Tuple3[T1, T2, T3, U any](tuple Tuple3[T1, T2, T3], mapper func(T1) U) Tuple3[U, T2, T3] {
+	return Tuple3[U, T2, T3]{mapper(tuple.v1), tuple.v2, tuple.v3}
+}
+
+// Map2Tuple3 maps the value at position 2 of a Tuple3 with the mapper passed as parameter.
+func Map2Tuple3[T1, T2, T3, U any](tuple Tuple3[T1, T2, T3], mapper func(T2) U) Tuple3[T1, U, T3] {
+	return Tuple3[T1, U, T3]{tuple.v1, mapper(tuple.v2), tuple.v3}
+}
+
+// Map3Tuple3 maps the value at position 3 of a Tuple3 with the mapper passed as parameter.
+func Map3Tuple3[T1, T2, T3, U any](tuple Tuple3[T1, T2, T3], mapper func(T3) U) Tuple3[T1, T2, U] {
+	return Tuple3[T1, T2, U]{tuple.v1, tuple.v2, mapper(tuple.v3)}
+}
+
+// Get1 returns the value at position 1 of the Tuple3.
+func (t Tuple3[T1, T2, T3]) Get1() T1 {
+	return t.v1
+}
+
+// Get2 returns the value at position 2 of the Tuple3.
+func (t Tuple3[T1, T2, T3]) Get2() T2 {
+	return t.v2
+}
+
+// Get3 returns the value at position 3 of the Tuple3.
+func (t Tuple3[T1, T2, T3]) Get3() T3 {
+	return t.v3
+}
+
+// Tuple4 is a product type containing 4 values of possibly different types.
+type Tuple4[T1, T2, T3, T4 any] struct {
+	v1 T1
+	v2 T2
+	v3 T3
+	v4 T4
+}
+
+// NewTuple4 returns a Tuple4 containing the values passed as parameter.
+func NewTuple4[T1, T2, T3, T4 any](v1 T1, v2 T2, v3 T3, v4 T4) Tuple4[T1, T2, T3, T4] {
+	return Tuple4[T1, T2, T3, T4]{v1, v2, v3, v4}
+}
+
+// Tuple4OfPairs returns a Tuple4 containing the values of right nested pairs.
+func Tuple4OfPairs[T1, T2, T3, T4 any](pairs Pair[T1, Pair[T2, Pair[T3, T4]]]) Tuple4[T1, T2, T3, T4] {
+	return Tuple4[T1, T2, T3, T4]{pairs.left, pairs.right.left, pairs.right.right.left, pairs.right.right.right}
+}
+
+// MapTuple4 maps each value of a Tuple4 to a new Tuple4 with the mappers passed as parameter.
+func MapTuple4[T1, T2, T3, T4, U1, U2, U3, U4 any](tuple Tuple4[T1, T2, T3, T4], mapper1 func(T1) U1, mapper2 func(T2) U2, mapper3 func(T3) U3, mapper4 func(T4) U4) Tuple4[U1, U2, U3, U4] {
+	return Tuple4[U1, U2, U3, U4]{mapper1(tuple.v1), mapper2(tuple.v2), mapper3(tuple.v3), mapper4(tuple.v4)}
+}
+
+// Map1Tuple4 maps the value at position 1 of a Tuple4 with the mapper passed as parameter.
+func Map1Tuple4[T1, T2, T3, T4, U any](tuple Tuple4[T1, T2, T3, T4], mapper func(T1) U) Tuple4[U, T2, T3, T4] {
+	return Tuple4[U, T2, T3, T4]{mapper(tuple.v1), tuple.v2, tuple.v3, tuple.v4}
+}
+
+// Map2Tuple4 maps the value at position 2 of a Tuple4 with the mapper passed as parameter.
+func Map2Tuple4[T1, T2, T3, T4, U any](tuple Tuple4[T1, T2, T3, T4], mapper func(T2) U) Tuple4[T1, U, T3, T4] {
+	return Tuple4[T1, U, T3, T4]{tuple.v1, mapper(tuple.v2), tuple.v3, tuple.v4}
+}
+
+// Map3Tuple4 maps the value at position 3 of a Tuple4 with the mapper passed as parameter.
+func Map3Tuple4[T1, T2, T3, T4, U any](tuple Tuple4[T1, T2, T3, T4], mapper func(T3) U) Tuple4[T1, T2, U, T4] {
+	return Tuple4[T1, T2, U, T4]{tuple.v1, tuple.v2, mapper(tuple.v3), tuple.v4}
+}
+
+// Map4Tuple4 maps the value at position 4 of a Tuple4 with the mapper passed as parameter.
+func Map4Tuple4[T1, T2, T3, T4, U any](tuple Tuple4[T1, T2, T3, T4], mapper func(T4) U) Tuple4[T1, T2, T3, U] {
+	return Tuple4[T1, T2, T3, U]{tuple.v1, tuple.v2, tuple.v3, mapper(tuple.v4)}
+}
+
+// Get1 returns the value at position 1 of the Tuple4.
+func (t Tuple4[T1, T2, T3, T4]) Get1() T1 {
+	return t.v1
+}
+
+// Get2 returns the value at position 2 of the Tuple4.
+func (t Tuple4[T1, T2, T3, T4]) Get2() T2 {
+	return t.v2
+}
+
+// Get3 returns the value at position 3 of the Tuple4.
+func (t Tuple4[T1, T2, T3, T4]) Get3() T3 {
+	return t.v3
+}
+
+// Get4 returns the value at position 4 of the Tuple4.
+func (t Tuple4[T1, T2, T3, T4]) Get4() T4 {
+	return t.v4
+}
+
+// Tuple5 is a product type containing 5 values of possibly different types.
+type Tuple5[T1, T2, T3, T4, T5 any] struct {
+	v1 T1
+	v2 T2
+	v3 T3
+	v4 T4
+	v5 T5
+}
+
+// NewTuple5 returns a Tuple5 containing the values passed as parameter.
+func NewTuple5[T1, T2, T3, T4, T5 any](v1 T1, v2 T2, v3 T3, v4 T4, v5 T5) Tuple5[T1, T2, T3, T4, T5] {
+	return Tuple5[T1, T2, T3, T4, T5]{v1, v2, v3, v4, v5}
+}
+
+// Tuple5OfPairs returns a Tuple5 containing the values of right nested pairs.
+func Tuple5OfPairs[T1, T2, T3, T4, T5 any](pairs Pair[T1, Pair[T2, Pair[T3, Pair[T4, T5]]]]) Tuple5[T1, T2, T3, T4, T5] {
+	return Tuple5[T1, T2, T3, T4, T5]{pairs.left, pairs.right.left, pairs.right.right.left, pairs.right.right.right.left, pairs.right.right.right.right}
+}
+
+// MapTuple5 maps each value of a Tuple5 to a new Tuple5 with the mappers passed as parameter.
+func MapTuple5[T1, T2, T3, T4, T5, U1, U2, U3, U4, U5 any](tuple Tuple5[T1, T2, T3, T4, T5], mapper1 func(T1) U1, mapper2 func(T2) U2, mapper3 func(T3) U3, mapper4 func(T4) U4, mapper5 func(T5) U5) Tuple5[U1, U2, U3, U4, U5] {
+	return Tuple5[U1, U2, U3, U4, U5]{mapper1(tuple.v1), mapper2(tuple.v2), mapper3(tuple.v3), mapper4(tuple.v4), mapper5(tuple.v5)}
+}
+
+// Map1Tuple5 maps the value at position 1 of a Tuple5 with the mapper passed as parameter.
+func Map1Tuple5[T1, T2, T3, T4, T5, U any](tuple Tuple5[T1, T2, T3, T4, T5], mapper func(T1) U) Tuple5[U, T2, T3, T4, T5] {
+	return Tuple5[U, T2, T3, T4, T5]{mapper(tuple.v1), tuple.v2, tuple.v3, tuple.v4, tuple.v5}
+}
+
+// Map2Tuple5 maps the value at position 2 of a Tuple5 with the mapper passed as parameter.
+func Map2Tuple5[T1, T2, T3, T4, T5, U any](tuple Tuple5[T1, T2, T3, T4, T5], mapper func(T2) U) Tuple5[T1, U, T3, T4, T5] {
+	return Tuple5[T1, U, T3, T4, T5]{tuple.v1, mapper(tuple.v2), tuple.v3, tuple.v4, tuple.v5}
+}
+
+// Map3Tuple5 maps the value at position 3 of a Tuple5 with the mapper passed as parameter.
+func Map3Tuple5[T1, T2, T3, T4, T5, U any](tuple Tuple5[T1, T2, T3, T4, T5], mapper func(T3) U) Tuple5[T1, T2, U, T4, T5] {
+	return Tuple5[T1, T2, U, T4, T5]{tuple.v1, tuple.v2, mapper(tuple.v3), tuple.v4, tuple.v5}
+}
+
+// Map4Tuple5 maps the value at position 4 of a Tuple5 with the mapper passed as parameter.
+func Map4Tuple5[T1, T2, T3, T4, T5, U any](tuple Tuple5[T1, T2, T3, T4, T5], mapper func(T4) U) Tuple5[T1, T2, T3, U, T5] {
+	return Tuple5[T1, T2, T3, U, T5]{tuple.v1, tuple.v2, tuple.v3, mapper(tuple.v4), tuple.v5}
+}
+
+// Map5Tuple5 maps the value at position 5 of a Tuple5 with the mapper passed as parameter.
+func Map5Tuple5[T1, T2, T3, T4, T5, U any](tuple Tuple5[T1, T2, T3, T4, T5], mapper func(T5) U) Tuple5[T1, T2, T3, T4, U] {
+	return Tuple5[T1, T2, T3, T4, U]{tuple.v1, tuple.v2, tuple.v3, tuple.v4, mapper(tuple.v5)}
+}
+
+// Get1 returns the value at position 1 of the Tuple5.
+func (t Tuple5[T1, T2, T3, T4, T5]) Get1() T1 {
+	return t.v1
+}
+
+// Get2 returns the value at position 2 of the Tuple5.
+func (t Tuple5[T1, T2, T3, T4, T5]) Get2() T2 {
+	return t.v2
+}
+
+// Get3 returns the value at position 3 of the Tuple5.
+func (t Tuple5[T1, T2, T3, T4, T5]) Get3() T3 {
+	return t.v3
+}
+
+// Get4 returns the value at position 4 of the Tuple5.
+func (t Tuple5[T1, T2, T3, T4, T5]) Get4() T4 {
+	return t.v4
+}
+
+// Get5 returns the value at position 5 of the Tuple5.
+func (t Tuple5[T1, T2, T3, T4, T5]) Get5() T5 {
+	return t.v5
+}
+
+// Tuple6 is a product type containing 6 values of possibly different types.
+type Tuple6[T1, T2, T3, T4, T5, T6 any] struct {
+	v1 T1
+	v2 T2
+	v3 T3
+	v4 T4
+	v5 T5
+	v6 T6
+}
+
+// NewTuple6 returns a Tuple6 containing the values passed as parameter.
+func NewTuple6[T1, T2, T3, T4, T5, T6 any](v1 T1, v2 T2, v3 T3, v4 T4, v5 T5, v6 T6) Tuple6[T1, T2, T3, T4, T5, T6] {
+	return Tuple6[T1, T2, T3, T4, T5, T6]{v1, v2, v3, v4, v5, v6}
+}
+
+// Tuple6OfPairs returns a Tuple6 containing the values of right nested pairs.
+func Tuple6OfPairs[T1, T2, T3, T4, T5, T6 any](pairs Pair[T1, Pair[T2, Pair[T3, Pair[T4, Pair[T5, T6]]]]]) Tuple6[T1, T2, T3, T4, T5, T6] {
+	return Tuple6[T1, T2, T3, T4, T5, T6]{pairs.left, pairs.right.left, pairs.right.right.left, pairs.right.right.right.left, pairs.right.right.right.right.left, pairs.right.right.right.right.right}
+}
+
+// MapTuple6 maps each value of a Tuple6 to a new Tuple6 with the mappers passed as parameter.
+func MapTuple6[T1, T2, T3, T4, T5, T6, U1, U2, U3, U4, U5, U6 any](tuple Tuple6[T1, T2, T3, T4, T5, T6], mapper1 func(T1) U1, mapper2 func(T2) U2, mapper3 func(T3) U3, mapper4 func(T4) U4, mapper5 func(T5) U5, mapper6 func(T6) U6) Tuple6[U1, U2, U3, U4, U5, U6] {
+	return Tuple6[U1, U2, U3, U4, U5, U6]{mapper1(tuple.v1), mapper2(tuple.v2), mapper3(tuple.v3), mapper4(tuple.v4), mapper5(tuple.v5), mapper6(tuple.v6)}
+}
+
+// Map1Tuple6 maps the value at position 1 of a Tuple6 with the mapper passed as parameter.
+func Map1Tuple6[T1, T2, T3, T4, T5, T6, U any](tuple Tuple6[T1, T2, T3, T4, T5, T6], mapper func(T1) U) Tuple6[U, T2, T3, T4, T5, T6] {
+	return Tuple6[U, T2, T3, T4, T5, T6]{mapper(tuple.v1), tuple.v2, tuple.v3, tuple.v4, tuple.v5, tuple.v6}
+}
+
+// Map2Tuple6 maps the value at position 2 of a Tuple6 with the mapper passed as parameter.
+func Map2Tuple6[T1, T2, T3, T4, T5, T6, U any](tuple Tuple6[T1, T2, T3, T4, T5, T6], mapper func(T2) U) Tuple6[T1, U, T3, T4, T5, T6] {
+	return Tuple6[T1, U, T3, T4, T5, T6]{tuple.v1, mapper(tuple.v2), tuple.v3, tuple.v4, tuple.v5, tuple.v6}
+}
+
+// Map3Tuple6 maps the value at position 3 of a Tuple6 with the mapper passed as parameter.
+func Map3Tuple6[T1, T2, T3, T4, T5, T6, U any](tuple Tuple6[T1, T2, T3, T4, T5, T6], mapper func(T3) U) Tuple6[T1, T2, U, T4, T5, T6] {
+	return Tuple6[T1, T2, U, T4, T5, T6]{tuple.v1, tuple.v2, mapper(tuple.v3), tuple.v4, tuple.v5, tuple.v6}
+}
+
+// Map4Tuple6 maps the value at position 4 of a Tuple6 with the mapper passed as parameter.
+func Map4Tuple6[T1, T2, T3, T4, T5, T6, U any](tuple Tuple6[T1, T2, T3, T4, T5, T6], mapper func(T4) U) Tuple6[T1, T2, T3, U, T5, T6] {
+	return Tuple6[T1, T2, T3, U, T5, T6]{tuple.v1, tuple.v2, tuple.v3, mapper(tuple.v4), tuple.v5, tuple.v6}
+}
+
+// Map5Tuple6 maps the value at position 5 of a Tuple6 with the mapper passed as parameter.
+func Map5Tuple6[T1, T2, T3, T4, T5, T6, U any](tuple Tuple6[T1, T2, T3, T4, T5, T6], mapper func(T5) U) Tuple6[T1, T2, T3, T4, U, T6] {
+	return Tuple6[T1, T2, T3, T4, U, T6]{tuple.v1, tuple.v2, tuple.v3, tuple.v4, mapper(tuple.v5), tuple.v6}
+}
+
+// Map6Tuple6 maps the value at position 6 of a Tuple6 with the mapper passed as parameter.
+func Map6Tuple6[T1, T2, T3, T4, T5, T6, U any](tuple Tuple6[T1, T2, T3, T4, T5, T6], mapper func(T6) U) Tuple6[T1, T2, T3, T4, T5, U] {
+	return Tuple6[T1, T2, T3, T4, T5, U]{tuple.v1, tuple.v2, tuple.v3, tuple.v4, tuple.v5, mapper(tuple.v6)}
+}
+
+// Get1 returns the value at position 1 of the Tuple6.
+func (t Tuple6[T1, T2, T3, T4, T5, T6]) Get1() T1 {
+	return t.v1
+}
+
+// Get2 returns the value at position 2 of the Tuple6.
+func (t Tuple6[T1, T2, T3, T4, T5, T6]) Get2() T2 {
+	return t.v2
+}
+
+// Get3 returns the value at position 3 of the Tuple6.
+func (t Tuple6[T1, T2, T3, T4, T5, T6]) Get3() T3 {
+	return t.v3
+}
+
+// Get4 returns the value at position 4 of the Tuple6.
+func (t Tuple6[T1, T2, T3, T4, T5, T6]) Get4() T4 {
+	return t.v4
+}
+
+// Get5 returns the value at position 5 of the Tuple6.
+func (t Tuple6[T1, T2, T3, T4, T5, T6]) Get5() T5 {
+	return t.v5
+}
+
+// Get6 returns the value at position 6 of the Tuple6.
+func (t Tuple6[T1, T2, T3, T4, T5, T6]) Get6() T6 {
+	return t.v6
+}
+
+// Tuple7 is a product type containing 7 values of possibly different types.
+type Tuple7[T1, T2, T3, T4, T5, T6, T7 any] struct {
+	v1 T1
+	v2 T2
+	v3 T3
+	v4 T4
+	v5 T5
+	v6 T6
+	v7 T7
+}
+
+// NewTuple7 returns a Tuple7 containing the values passed as parameter.
+func NewTuple7[T1, T2, T3, T4, T5, T6, T7 any](v1 T1, v2 T2, v3 T3, v4 T4, v5 T5, v6 T6, v7 T7) Tuple7[T1, T2, T3, T4, T5, T6, T7] {
+	return Tuple7[T1, T2, T3, T4, T5, T6, T7]{v1, v2, v3, v4, v5, v6, v7}
+}
+
+// Tuple7OfPairs returns a Tuple7 containing the values of right nested pairs.
+func Tuple7OfPairs[T1, T2, T3, T4, T5, T6, T7 any](pairs Pair[T1, Pair[T2, Pair[T3, Pair[T4, Pair[T5, Pair[T6, T7]]]]]]) Tuple7[T1, T2, T3, T4, T5, T6, T7] {
+	return Tuple7[T1, T2, T3, T4, T5, T6, T7]{pairs.left, pairs.right.left, pairs.right.right.left, pairs.right.right.right.left, pairs.right.right.right.right.left, pairs.right.right.right.right.right.left, pairs.right.right.right.right.right.right}
+}
+
+// MapTuple7 maps each value of a Tuple7 to a new Tuple7 with the mappers passed as parameter.
+func MapTuple7[T1, T2, T3, T4, T5, T6, T7, U1, U2, U3, U4, U5, U6, U7 any](tuple Tuple7[T1, T2, T3, T4, T5, T6, T7], mapper1 func(T1) U1, mapper2 func(T2) U2, mapper3 func(T3) U3, mapper4 func(T4) U4, mapper5 func(T5) U5, mapper6 func(T6) U6, mapper7 func(T7) U7) Tuple7[U1, U2, U3, U4, U5, U6, U7] {
+	return Tuple7[U1, U2, U3, U4, U5, U6, U7]{mapper1(tuple.v1), mapper2(tuple.v2), mapper3(tuple.v3), mapper4(tuple.v4), mapper5(tuple.v5), mapper6(tuple.v6), mapper7(tuple.v7)}
+}
+
+// Map1Tuple7 maps the value at position 1 of a Tuple7 with the mapper passed as parameter.
+func Map1Tuple7[T1, T2, T3, T4, T5, T6, T7, U any](tuple Tuple7[T1, T2, T3, T4, T5, T6, T7], mapper func(T1) U) Tuple7[U, T2, T3, T4, T5, T6, T7] {
+	return Tuple7[U, T2, T3, T4, T5, T6, T7]{mapper(tuple.v1), tuple.v2, tuple.v3, tuple.v4, tuple.v5, tuple.v6, tuple.v7}
+}
+
+// Map2Tuple7 maps the value at position 2 of a Tuple7 with the mapper passed as parameter.
+func Map2Tuple7[T1, T2, T3, T4, T5, T6, T7, U any](tuple Tuple7[T1, T2, T3, T4, T5, T6, T7], mapper func(T2) U) Tuple7[T1, U, T3, T4, T5, T6, T7] {
+	return Tuple7[T1, U, T3, T4, T5, T6, T7]{tuple.v1, mapper(tuple.v2), tuple.v3, tuple.v4, tuple.v5, tuple.v6, tuple.v7}
+}
+
+// Map3Tuple7 maps the value at position 3 of a Tuple7 with the mapper passed as parameter.
+func Map3Tuple7[T1, T2, T3, T4, T5, T6, T7, U any](tuple Tuple7[T1, T2, T3, T4, T5, T6, T7], mapper func(T3) U) Tuple7[T1, T2, U, T4, T5, T6, T7] {
+	return Tuple7[T1, T2, U, T4, T5, T6, T7]{tuple.v1, tuple.v2, mapper(tuple.v3), tuple.v4, tuple.v5, tuple.v6, tuple.v7}
+}
+
+// Map4Tuple7 maps the value at position 4 of a Tuple7 with the mapper passed as parameter.
+func Map4Tuple7[T1, T2, T3, T4, T5, T6, T7, U any](tuple Tuple7[T1, T2, T3, T4, T5, T6, T7], mapper func(T4) U) Tuple7[T1, T2, T3, U, T5, T6, T7] {
+	return Tuple7[T1, T2, T3, U, T5, T6, T7]{tuple.v1, tuple.v2, tuple.v3, mapper(tuple.v4), tuple.v5, tuple.v6, tuple.v7}
+}
+
+// Map5Tuple7 maps the value at position 5 of a Tuple7 with the mapper passed as parameter.
+func Map5Tuple7[T1, T2, T3, T4, T5, T6, T7, U any](tuple Tuple7[T1, T2, T3, T4, T5, T6, T7], mapper func(T5) U) Tuple7[T1, T2, T3, T4, U, T6, T7] {
+	return Tuple7[T1, T2, T3, T4, U, T6, T7]{tuple.v1, tuple.v2, tuple.v3, tuple.v4, mapper(tuple.v5), tuple.v6, tuple.v7}
+}
+
+// Map6Tuple7 maps the value at position 6 of a Tuple7 with the mapper passed as parameter.
+func Map6Tuple7[T1, T2, T3, T4, T5, T6, T7, U any](tuple Tuple7[T1, T2, T3, T4, T5, T6, T7], mapper func(T6) U) Tuple7[T1, T2, T3, T4, T5, U, T7] {
+	return Tuple7[T1, T2, T3, T4, T5, U, T7]{tuple.v1, tuple.v2, tuple.v3, tuple.v4, tuple.v5, mapper(tuple.v6), tuple.v7}
+}
+
+// Map7Tuple7 maps the value at position 7 of a Tuple7 with the mapper passed as parameter.
+func Map7Tuple7[T1, T2, T3, T4, T5, T6, T7, U any](tuple Tuple7[T1, T2, T3, T4, T5, T6, T7], mapper func(T7) U) Tuple7[T1, T2, T3, T4, T5, T6, U] {
+	return Tuple7[T1, T2, T3, T4, T5, T6, U]{tuple.v1, tuple.v2, tuple.v3, tuple.v4, tuple.v5, tuple.v6, mapper(tuple.v7)}
+}
+
+// Get1 returns the value at position 1 of the Tuple7.
+func (t Tuple7[T1, T2, T3, T4, T5, T6, T7]) Get1() T1 {
+	return t.v1
+}
+
+// Get2 returns the value at position 2 of the Tuple7.
+func (t Tuple7[T1, T2, T3, T4, T5, T6, T7]) Get2() T2 {
+	return t.v2
+}
+
+// Get3 returns the value at position 3 of the Tuple7.
+func (t Tuple7[T1, T2, T3, T4, T5, T6, T7]) Get3() T3 {
+	return t.v3
+}
+
+// Get4 returns the value at position 4 of the Tuple7.
+func (t Tuple7[T1, T2, T3, T4, T5, T6, T7]) Get4() T4 {
+	return t.v4
+}
+
+// Get5 returns the value at position 5 of the Tuple7.
+func (t Tuple7[T1, T2, T3, T4, T5, T6, T7]) Get5() T5 {
+	return t.v5
+}
+
+// Get6 returns the value at position 6 of the Tuple7.
+func (t Tuple7[T1, T2, T3, T4, T5, T6, T7]) Get6() T6 {
+	return t.v6
+}
+
+// Get7 returns the value at position 7 of the Tuple7.
+func (t Tuple7[T1, T2, T3, T4, T5, T6, T7]) Get7() T7 {
+	return t.v7
+}
+
+// Tuple8 is a product type containing 8 values of possibly different types.
+type Tuple8[T1, T2, T3, T4, T5, T6, T7, T8 any] struct {
+	v1 T1
+	v2 T2
+	v3 T3
+	v4 T4
+	v5 T5
+	v6 T6
+	v7 T7
+	v8 T8
+}
+
+// NewTuple8 returns a Tuple8 containing the values passed as parameter.
+func NewTuple8[T1, T2, T3, T4, T5, T6, T7, T8 any](v1 T1, v2 T2, v3 T3, v4 T4, v5 T5, v6 T6, v7 T7, v8 T8) Tuple8[T1, T2, T3, T4, T5, T6, T7, T8] {
+	return Tuple8[T1, T2, T3, T4, T5, T6, T7, T8]{v1, v2, v3, v4, v5, v6, v7, v8}
+}
+
+// Tuple8OfPairs returns a Tuple8 containing the values of right nested pairs.
+func Tuple8OfPairs[T1, T2, T3, T4, T5, T6, T7, T8 any](pairs Pair[T1, Pair[T2, Pair[T3, Pair[T4, Pair[T5, Pair[T6, Pair[T7, T8]]]]]]]) Tuple8[T1, T2, T3, T4, T5, T6, T7, T8] {
+	return Tuple8[T1, T2, T3, T4, T5, T6, T7, T8]{pairs.left, pairs.right.left, pairs.right.right.left, pairs.right.right.right.left, pairs.right.right.right.right.left, pairs.right.right.right.right.right.left, pairs.right.right.right.right.right.right.left, pairs.right.right.right.right.right.right.right}
+}
+
+// MapTuple8 maps each value of a Tuple8 to a new Tuple8 with the mappers passed as parameter.
+func MapTuple8[T1, T2, T3, T4, T5, T6, T7, T8, U1, U2, U3, U4, U5, U6, U7, U8 any](tuple Tuple8[T1, T2, T3, T4, T5, T6, T7, T8], mapper1 func(T1) U1, mapper2 func(T2) U2, mapper3 func(T3) U3, mapper4 func(T4) U4, mapper5 func(T5) U5, mapper6 func(T6) U6, mapper7 func(T7) U7, mapper8 func(T8) U8) Tuple8[U1, U2, U3, U4, U5, U6, U7, U8] {
+	return Tuple8[U1, U2, U3, U4, U5, U6, U7, U8]{mapper1(tuple.v1), mapper2(tuple.v2), mapper3(tuple.v3), mapper4(tuple.v4), mapper5(tuple.v5), mapper6(tuple.v6), mapper7(tuple.v7), mapper8(tuple.v8)}
+}
+
+// Map1Tuple8 maps the value at position 1 of a Tuple8 with the mapper passed as parameter.
+func Map1Tuple8[T1, T2, T3, T4, T5, T6, T7, T8, U any](tuple Tuple8[T1, T2, T3, T4, T5, T6, T7, T8], mapper func(T1) U) Tuple8[U, T2, T3, T4, T5, T6, T7, T8] {
+	return Tuple8[U, T2, T3, T4, T5, T6, T7, T8]{mapper(tuple.v1), tuple.v2, tuple.v3, tuple.v4, tuple.v5, tuple.v6, tuple.v7, tuple.v8}
+}
+
+// Map2Tuple8 maps the value at position 2 of a Tuple8 with the mapper passed as parameter.
+func Map2Tuple8[T1, T2, T3, T4, T5, T6, T7, T8, U any](tuple Tuple8[T1, T2, T3, T4, T5, T6, T7, T8], mapper func(T2) U) Tuple8[T1, U, T3, T4, T5, T6, T7, T8] {
+	return Tuple8[T1, U, T3, T4, T5, T6, T7, T8]{tuple.v1, mapper(tuple.v2), tuple.v3, tuple.v4, tuple.v5, tuple.v6, tuple.v7, tuple.v8}
+}
+
+// Map3Tuple8 maps the value at position 3 of a Tuple8 with the mapper passed as parameter.
+func Map3Tuple8[T1, T2, T3, T4, T5, T6, T7, T8, U any](tuple Tuple8[T1, T2, T3, T4, T5, T6, T7, T8], mapper func(T3) U) Tuple8[T1, T2, U, T4, T5, T6, T7, T8] {
+	return Tuple8[T1, T2, U, T4, T5, T6, T7, T8]{tuple.v1, tuple.v2, mapper(tuple.v3), tuple.v4, tuple.v5, tuple.v6, tuple.v7, tuple.v8}
+}
+
+// Map4Tuple8 maps the value at position 4 of a Tuple8 with the mapper passed as parameter.
+func Map4Tuple8[T1, T2, T3, T4, T5, T6, T7, T8, U any](tuple Tuple8[T1, T2, T3, T4, T5, T6, T7, T8], mapper func(T4) U) Tuple8[T1, T2, T3, U, T5, T6, T7, T8] {
+	return Tuple8[T1, T2, T3, U, T5, T6, T7, T8]{tuple.v1, tuple.v2, tuple.v3, mapper(tuple.v4), tuple.v5, tuple.v6, tuple.v7, tuple.v8}
+}
+
+// Map5Tuple8 maps the value at position 5 of a Tuple8 with the mapper passed as parameter.
+func Map5Tuple8[T1, T2, T3, T4, T5, T6, T7, T8, U any](tuple Tuple8[T1, T2, T3, T4, T5, T6, T7, T8], mapper func(T5) U) Tuple8[T1, T2, T3, T4, U, T6, T7, T8] {
+	return Tuple8[T1, T2, T3, T4, U, T6, T7, T8]{tuple.v1, tuple.v2, tuple.v3, tuple.v4, mapper(tuple.v5), tuple.v6, tuple.v7, tuple.v8}
+}
+
+// Map6Tuple8 maps the value at position 6 of a Tuple8 with the mapper passed as parameter.
+func Map6Tuple8[T1, T2, T3, T4, T5, T6, T7, T8, U any](tuple Tuple8[T1, T2, T3, T4, T5, T6, T7, T8], mapper func(T6) U) Tuple8[T1, T2, T3, T4, T5, U, T7, T8] {
+	return Tuple8[T1, T2, T3, T4, T5, U, T7, T8]{tuple.v1, tuple.v2, tuple.v3, tuple.v4, tuple.v5, mapper(tuple.v6), tuple.v7, tuple.v8}
+}
+
+// Map7Tuple8 maps the value at position 7 of a Tuple8 with the mapper passed as parameter.
+func Map7Tuple8[T1, T2, T3, T4, T5, T6, T7, T8, U any](tuple Tuple8[T1, T2, T3, T4, T5, T6, T7, T8], mapper func(T7) U) Tuple8[T1, T2, T3, T4, T5, T6, U, T8] {
+	return Tuple8[T1, T2, T3, T4, T5, T6, U, T8]{tuple.v1, tuple.v2, tuple.v3, tuple.v4, tuple.v5, tuple.v6, mapper(tuple.v7), tuple.v8}
+}
+
+// Map8Tuple8 maps the value at position 8 of a Tuple8 with the mapper passed as parameter.
+func Map8Tuple8[T1, T2, T3, T4, T5, T6, T7, T8, U any](tuple Tuple8[T1, T2, T3, T4, T5, T6, T7, T8], mapper func(T8) U) Tuple8[T1, T2, T3, T4, T5, T6, T7, U] {
+	return Tuple8[T1, T2, T3, T4, T5, T6, T7, U]{tuple.v1, tuple.v2, tuple.v3, tuple.v4, tuple.v5, tuple.v6, tuple.v7, mapper(tuple.v8)}
+}
+
+// Get1 returns the value at position 1 of the Tuple8.
+func (t Tuple8[T1, T2, T3, T4, T5, T6, T7, T8]) Get1() T1 {
+	return t.v1
+}
+
+// Get2 returns the value at position 2 of the Tuple8.
+func (t Tuple8[T1, T2, T3, T4, T5, T6, T7, T8]) Get2() T2 {
+	return t.v2
+}
+
+// Get3 returns the value at position 3 of the Tuple8.
+func (t Tuple8[T1, T2, T3, T4, T5, T6, T7, T8]) Get3() T3 {
+	return t.v3
+}
+
+// Get4 returns the value at position 4 of the Tuple8.
+func (t Tuple8[T1, T2, T3, T4, T5, T6, T7, T8]) Get4() T4 {
+	return t.v4
+}
+
+// Get5 returns the value at position 5 of the Tuple8.
+func (t Tuple8[T1, T2, T3, T4, T5, T6, T7, T8]) Get5() T5 {
+	return t.v5
+}
+
+// Get6 returns the value at position 6 of the Tuple8.
+func (t Tuple8[T1, T2, T3, T4, T5, T6, T7, T8]) Get6() T6 {
+	return t.v6
+}
+
+// Get7 returns the value at position 7 of the Tuple8.
+func (t Tuple8[T1, T2, T3, T4, T5, T6, T7, T8]) Get7() T7 {
+	return t.v7
+}
+
+// Get8 returns the value at position 8 of the Tuple8.
+func (t Tuple8[T1, T2, T3, T4, T5, T6, T7, T8]) Get8() T8 {
+	return t.v8
+}
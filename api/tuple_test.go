@@ -0,0 +1,47 @@
+package api
+
+import (
+	"fmt"
+	"gotest.tools/v3/assert"
+	"strconv"
+	"testing"
+)
+
+var (
+	tuple3 = NewTuple3(10, "ten", true)
+	tuple8 = NewTuple8(1, "2", 3.0, '4', uint(5), int8(6), []int{7}, false)
+)
+
+func TestTupleGetters(t *testing.T) {
+	assert.Equal(t, tuple3.Get1(), 10, fmt.Sprintf("value should be 10 but is %d", tuple3.Get1()))
+	assert.Equal(t, tuple3.Get2(), "ten", fmt.Sprintf("value should be 'ten' but is '%s'", tuple3.Get2()))
+	assert.Equal(t, tuple3.Get3(), true, fmt.Sprintf("value should be true but is %t", tuple3.Get3()))
+
+	assert.Equal(t, tuple8.Get1(), 1)
+	assert.Equal(t, tuple8.Get4(), '4')
+	assert.DeepEqual(t, tuple8.Get7(), []int{7})
+	assert.Equal(t, tuple8.Get8(), false)
+}
+
+func TestTupleOfPairs(t *testing.T) {
+	fromPairs := Tuple3OfPairs(NewPair(10, NewPair("ten", true)))
+	assert.Equal(t, fromPairs, tuple3)
+
+	tuple5 := Tuple5OfPairs(NewPair(1, NewPair(2, NewPair(3, NewPair(4, 5)))))
+	assert.Equal(t, tuple5, NewTuple5(1, 2, 3, 4, 5))
+}
+
+func TestMapTuple(t *testing.T) {
+	mapped := MapTuple3(tuple3, strconv.Itoa, func(value string) int { return len(value) }, func(value bool) string { return strconv.FormatBool(value) })
+	assert.Equal(t, mapped, NewTuple3("10", 3, "true"))
+}
+
+func TestMapTupleSlot(t *testing.T) {
+	assert.Equal(t, Map1Tuple3(tuple3, strconv.Itoa), NewTuple3("10", "ten", true))
+	assert.Equal(t, Map2Tuple3(tuple3, func(value string) int { return len(value) }), NewTuple3(10, 3, true))
+	assert.Equal(t, Map3Tuple3(tuple3, func(value bool) bool { return !value }), NewTuple3(10, "ten", false))
+
+	mapped := Map8Tuple8(tuple8, func(value bool) string { return strconv.FormatBool(value) })
+	assert.Equal(t, mapped.Get8(), "false")
+	assert.Equal(t, mapped.Get1(), 1)
+}